@@ -12,6 +12,13 @@
 //	- Tracking visited nodes and the path taken during a tour
 //	- Selecting the next node to visit probabilistically using pheromone and distance info
 //	- Constructing a complete tour starting from a root node and returning to it
+//	- Reporting whether a complete, finite-cost cycle could be built at all
+//	- Drawing random decisions from a per-ant generator, so ants can run concurrently
+//	- Restricting moves to nearest-neighbour candidate lists, with a full-scan fallback
+//	- Open tours (paths) that do not return to the root node
+//	- Pluggable heuristic desirability, defaulting to inverse distance
+//	- Roulette-wheel or tournament selection of the next node
+//	- Ant Colony System pseudo-random proportional rule (greedy exploitation with probability q0)
 //
 //	This package works closely with the Graph package (problem graph representation)
 //	and the Pheromone package (pheromone matrix managing edge desirability).
@@ -25,32 +32,59 @@ package ant
 import (
 	"math"
 	"math/rand"
-	"time"
 
 	graph "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Graph"
 	pheromone "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Pheromone"
 )
 
+// SelectionMode selects how an ant picks its next node among the weighted moves.
+type SelectionMode int
+
+const (
+	// RouletteSelection picks every move with probability proportional to its weight.
+	RouletteSelection SelectionMode = iota
+
+	// TournamentSelection samples a few unvisited nodes uniformly and picks the one with the
+	// highest weight. Weights are only compared, never summed or normalized, so the selection
+	// is cheaper and does not suffer from floating-point underflow on large graphs.
+	TournamentSelection
+)
+
+// DefaultTournamentSize is the number of nodes sampled per move by TournamentSelection when
+// TournamentSize is not set.
+const DefaultTournamentSize int = 3
+
 // Ant represents a single ant in the Ant Colony Optimization algorithm.
 //
-// It tracks visited nodes, the path taken, total cost of the tour, and
-// references to the problem graph and pheromone matrix. The parameters alpha
+// It tracks visited nodes, the path taken, total cost of the tour, whether
+// the tour is a valid complete cycle, and references to the problem graph
+// and pheromone matrix. The parameters alpha
 // and beta control the influence of pheromone intensity and visibility
-// (heuristic information) when selecting the next node.
+// (heuristic information) when selecting the next node. Each ant owns its random
+// number generator, so ants never contend on shared random state. Optional candidate
+// lists restrict each move to the nearest neighbours of the current node. ReturnToStart
+// (true by default) controls whether the tour closes with an edge back to the root.
+// Heuristic, when set, replaces the inverse-distance visibility of every move, so problem
+// constraints such as time windows can shape the selection probabilities. Selection chooses
+// between roulette-wheel (the default) and tournament selection, where TournamentSize nodes
+// are sampled per move (DefaultTournamentSize when 0 or less). Q0 enables the Ant Colony
+// System rule: with probability Q0 a move greedily takes the highest-weight node instead.
 type Ant struct {
-	visitedNodes map[int]bool
-	PathTaken    []int
-	TotalCost    float64
-	problemGraph *graph.Graph
-	pheromones   *pheromone.PheromoneMatrix
-	alpha        float64
-	beta         float64
-}
-
-var randomNumberGenerator *rand.Rand
-
-func init() {
-	randomNumberGenerator = rand.New(rand.NewSource(time.Now().UnixNano()))
+	visitedNodes   map[int]bool
+	PathTaken      []int
+	TotalCost      float64
+	ValidTour      bool
+	ReturnToStart  bool
+	Heuristic      func(from int, to int) float64
+	Selection      SelectionMode
+	TournamentSize int
+	Q0             float64
+	problemGraph   *graph.Graph
+	pheromones     *pheromone.PheromoneMatrix
+	alpha          float64
+	beta           float64
+	random         *rand.Rand
+	candidates     [][]int
 }
 
 // NewAnt creates and initializes a new Ant instance with the given problem graph,
@@ -62,27 +96,50 @@ func init() {
 //	pheromones - pheromone matrix controlling pheromone levels on edges
 //	alpha      - influence of pheromone strength on path selection
 //	beta       - influence of heuristic visibility on path selection
+//	random     - random number generator used exclusively by this ant
 //
 // Returns:
 //
 //	Pointer to the newly created Ant instance.
-func NewAnt(graph *graph.Graph, pheromones *pheromone.PheromoneMatrix, alpha, beta float64) *Ant {
+func NewAnt(graph *graph.Graph, pheromones *pheromone.PheromoneMatrix, alpha, beta float64, random *rand.Rand) *Ant {
 	return &Ant{
-		visitedNodes: make(map[int]bool),
-		PathTaken:    make([]int, 0, graph.NumberOfNodes),
-		TotalCost:    0.0,
-		problemGraph: graph,
-		pheromones:   pheromones,
-		alpha:        alpha,
-		beta:         beta,
+		visitedNodes:  make(map[int]bool),
+		PathTaken:     make([]int, 0, graph.NumberOfNodes),
+		TotalCost:     0.0,
+		ReturnToStart: true,
+		problemGraph:  graph,
+		pheromones:    pheromones,
+		alpha:         alpha,
+		beta:          beta,
+		random:        random,
 	}
 }
 
+// SetCandidateLists gives the ant a precomputed candidate list per node, typically the k
+// nearest neighbours from Graph.NearestNeighbors. While choosing its next move the ant then
+// considers only the unvisited candidates of the current node, and falls back to every
+// unvisited node only when none of those candidates can be taken. Passing nil restores the
+// full scan on every move.
+//
+// Parameters:
+//
+//	candidates - candidate lists indexed by node; the slices are only read, never modified
+func (ant *Ant) SetCandidateLists(candidates [][]int) {
+	ant.candidates = candidates
+}
+
 // SelectNextNode chooses the next node for the ant to move to from the current node.
 //
 // It calculates the probability of moving to each unvisited neighbor based on pheromone
 // levels raised to the power alpha and heuristic visibility raised to the power beta.
-// Then, it performs roulette wheel selection to probabilistically select the next node.
+// Visibility is 1 / distance unless a Heuristic is set; a heuristic value of 0 rules the
+// move out, just like an infinite distance does by default.
+// Then, it performs roulette wheel selection to probabilistically select the next node, or
+// a tournament among a few sampled nodes when Selection is TournamentSelection. When Q0 is
+// positive, the move is instead, with probability Q0, the node with the highest weight
+// (exploitation), so a Q0 of 1 makes every move greedy and deterministic.
+// When candidate lists are set, only the current node's candidates are evaluated first,
+// which makes a move cost O(k) instead of O(n) in the common case.
 //
 // Parameters:
 //
@@ -92,30 +149,112 @@ func NewAnt(graph *graph.Graph, pheromones *pheromone.PheromoneMatrix, alpha, be
 //
 //	The index of the selected next node, or -1 if no valid moves are available.
 func (ant *Ant) SelectNextNode(currentNode int) int {
-	var nodeCount int = ant.problemGraph.NumberOfNodes
+	if ant.candidates != nil {
+		if nextNode := ant.selectAmong(currentNode, ant.candidates[currentNode]); nextNode != -1 {
+			return nextNode
+		}
+	}
+
+	// Fallback: every candidate is visited (or unreachable), so consider all nodes.
+	return ant.selectAmong(currentNode, nil)
+}
+
+// selectAmong performs the configured selection of SelectNextNode over the given nodes,
+// or over every node of the graph when nodes is nil.
+func (ant *Ant) selectAmong(currentNode int, nodes []int) int {
+	// No random number is drawn while Q0 is unset, so seeded runs are unaffected by the rule.
+	if ant.Q0 > 0 && ant.random.Float64() < ant.Q0 {
+		return ant.greedyAmong(currentNode, nodes)
+	}
+
+	if ant.Selection == TournamentSelection {
+		return ant.tournamentAmong(currentNode, nodes)
+	}
+
+	return ant.rouletteAmong(currentNode, nodes)
+}
+
+// weight returns the unnormalized desirability pheromone^alpha * visibility^beta of moving
+// from currentNode to nextNode.
+func (ant *Ant) weight(currentNode int, nextNode int) float64 {
+	const EPSILON float64 = 1e-10
+
+	var pheromoneStrength float64 = math.Pow(ant.pheromones.Values[currentNode][nextNode], ant.alpha)
+	var visibility float64 = 0.0
+
+	if ant.Heuristic != nil {
+		visibility = math.Pow(ant.Heuristic(currentNode, nextNode), ant.beta)
+	} else {
+		var distance float64 = ant.problemGraph.DistanceBetween(currentNode, nextNode)
+
+		visibility = math.Pow(1.0/(distance+EPSILON), ant.beta)
+	}
+
+	return pheromoneStrength * visibility
+}
+
+// greedyAmong returns the unvisited node among the given nodes (every node of the graph when
+// nodes is nil) with the highest weight, preferring the earliest on ties, or -1 if every move
+// is ruled out.
+func (ant *Ant) greedyAmong(currentNode int, nodes []int) int {
+	var nodeCount int = len(nodes)
+
+	if nodes == nil {
+		nodeCount = ant.problemGraph.NumberOfNodes
+	}
+
+	var bestNode int = -1
+	var bestWeight float64 = 0.0
+
+	for index := 0; index < nodeCount; index++ {
+		var nextNode int = index
+
+		if nodes != nil {
+			nextNode = nodes[index]
+		}
+
+		if ant.visitedNodes[nextNode] || nextNode == currentNode {
+			continue
+		}
+
+		if weight := ant.weight(currentNode, nextNode); weight > bestWeight {
+			bestWeight = weight
+			bestNode = nextNode
+		}
+	}
+
+	return bestNode
+}
 
-	// Slice to hold move probabilities for each node.
+// rouletteAmong performs roulette wheel selection over the given nodes, or over every node
+// of the graph when nodes is nil.
+func (ant *Ant) rouletteAmong(currentNode int, nodes []int) int {
+	var nodeCount int = len(nodes)
+
+	if nodes == nil {
+		nodeCount = ant.problemGraph.NumberOfNodes
+	}
+
+	// Slice to hold move probabilities for each considered node.
 	var probabilityList []float64 = make([]float64, nodeCount)
 
 	var probabilitySum float64 = 0.0
-	var pheromoneStrength float64 = 0.0
-	var distance float64 = 0.0
-	var visibility float64 = 0.0
+	var nextNode int = 0
 
-	const EPSILON float64 = 1e-10
+	for index := 0; index < nodeCount; index++ {
+		nextNode = index
+
+		if nodes != nil {
+			nextNode = nodes[index]
+		}
 
-	for nextNode := 0; nextNode < nodeCount; nextNode++ {
 		// Skip nodes already visited or the current node itself.
 		if ant.visitedNodes[nextNode] || nextNode == currentNode {
 			continue
 		}
 
-		pheromoneStrength = math.Pow(ant.pheromones.Values[currentNode][nextNode], ant.alpha)
-		distance = ant.problemGraph.DistanceBetween(currentNode, nextNode)
-		visibility = math.Pow(1.0/(distance+EPSILON), ant.beta)
-
-		probabilityList[nextNode] = pheromoneStrength * visibility
-		probabilitySum += probabilityList[nextNode]
+		probabilityList[index] = ant.weight(currentNode, nextNode)
+		probabilitySum += probabilityList[index]
 	}
 
 	// Safe check to avoid division by zero.
@@ -129,13 +268,17 @@ func (ant *Ant) SelectNextNode(currentNode int) int {
 	}
 
 	// Roulette wheel selection.
-	var randomValue = randomNumberGenerator.Float64()
+	var randomValue = ant.random.Float64()
 	var cumulativeProbability float64 = 0.0
 
 	for index, probability := range probabilityList {
 		cumulativeProbability += probability
 
 		if randomValue <= cumulativeProbability {
+			if nodes != nil {
+				return nodes[index]
+			}
+
 			return index
 		}
 	}
@@ -144,20 +287,84 @@ func (ant *Ant) SelectNextNode(currentNode int) int {
 	return -1
 }
 
+// tournamentAmong samples up to TournamentSize distinct unvisited nodes among the given nodes
+// (every node of the graph when nodes is nil) and returns the one with the highest weight.
+// Only the sampled nodes are weighted. If every sampled move is ruled out, it falls back to
+// roulette wheel selection so that a reachable node is still found.
+func (ant *Ant) tournamentAmong(currentNode int, nodes []int) int {
+	var nodeCount int = len(nodes)
+
+	if nodes == nil {
+		nodeCount = ant.problemGraph.NumberOfNodes
+	}
+
+	var unvisited []int = make([]int, 0, nodeCount)
+
+	for index := 0; index < nodeCount; index++ {
+		var nextNode int = index
+
+		if nodes != nil {
+			nextNode = nodes[index]
+		}
+
+		if !ant.visitedNodes[nextNode] && nextNode != currentNode {
+			unvisited = append(unvisited, nextNode)
+		}
+	}
+
+	var tournamentSize int = ant.TournamentSize
+
+	if tournamentSize <= 0 {
+		tournamentSize = DefaultTournamentSize
+	}
+
+	var bestNode int = -1
+	var bestWeight float64 = 0.0
+
+	// Sample without replacement through a partial Fisher-Yates shuffle.
+	for round := 0; round < tournamentSize && round < len(unvisited); round++ {
+		var pick int = round + ant.random.Intn(len(unvisited)-round)
+
+		unvisited[round], unvisited[pick] = unvisited[pick], unvisited[round]
+
+		if weight := ant.weight(currentNode, unvisited[round]); weight > bestWeight {
+			bestWeight = weight
+			bestNode = unvisited[round]
+		}
+	}
+
+	if bestNode != -1 {
+		return bestNode
+	}
+
+	return ant.rouletteAmong(currentNode, nodes)
+}
+
 // ConstructTour builds a complete tour for the ant starting from rootNode.
 //
 // The ant repeatedly selects the next node probabilistically until all nodes are visited,
 // then returns to the root node to complete the cycle. It tracks the path taken and
-// accumulates the total cost of the tour.
+// accumulates the total cost of the tour. When ReturnToStart is false the tour is an open
+// path: the closing edge is neither appended nor counted in TotalCost.
+//
+// If the ant reaches a dead end before visiting every node, or the closing edge back to
+// the root is unusable (infinite or NaN distance), no Hamiltonian cycle was built: the
+// partial path is kept without a closing edge, TotalCost is set to +Inf, and ValidTour
+// is false.
 //
 // Parameters:
 //
 //	rootNode - the starting node for the ant's tour
-func (ant *Ant) ConstructTour(rootNode int) {
+//
+// Returns:
+//
+//	True if a complete, finite-cost tour was constructed; false otherwise.
+func (ant *Ant) ConstructTour(rootNode int) bool {
 	// Reset states.
 	ant.visitedNodes = make(map[int]bool)
 	ant.PathTaken = ant.PathTaken[:0]
 	ant.TotalCost = 0.0
+	ant.ValidTour = false
 
 	ant.PathTaken = append(ant.PathTaken, rootNode)
 	ant.visitedNodes[rootNode] = true
@@ -179,7 +386,27 @@ func (ant *Ant) ConstructTour(rootNode int) {
 		currentNode = nextNode
 	}
 
-	// Return to root node.
-	ant.PathTaken = append(ant.PathTaken, rootNode)
-	ant.TotalCost += ant.problemGraph.DistanceBetween(currentNode, rootNode)
+	// Dead end: not every node could be reached.
+	if len(ant.PathTaken) < ant.problemGraph.NumberOfNodes {
+		ant.TotalCost = math.Inf(1)
+
+		return false
+	}
+
+	// Return to root node, unless the tour is an open path.
+	if ant.ReturnToStart {
+		ant.PathTaken = append(ant.PathTaken, rootNode)
+		ant.TotalCost += ant.problemGraph.DistanceBetween(currentNode, rootNode)
+	}
+
+	// The closing edge (or any edge along the way) may be missing.
+	if math.IsInf(ant.TotalCost, 0) || math.IsNaN(ant.TotalCost) {
+		ant.TotalCost = math.Inf(1)
+
+		return false
+	}
+
+	ant.ValidTour = true
+
+	return true
 }
@@ -14,6 +14,26 @@
 //	- Initialization with problem graph and parameters
 //	- Running the optimization to find a near-optimal tour
 //	- Pheromone evaporation and deposition to balance exploration/exploitation
+//	- Concurrent tour construction using a worker pool of goroutines
+//	- Seeded random number generation for reproducible runs
+//	- Max-Min Ant System pheromone bounds and best-tour-only deposit modes
+//	- Context cancellation between epochs and early stopping on stagnation
+//	- Optional 2-opt local search refinement of every constructed tour
+//	- Per-epoch progress callback for logging and convergence monitoring
+//	- Best-cost history per epoch for plotting convergence
+//	- Copies of every ant's tour from the final epoch for inspecting the population
+//	- Incomplete tours (dead ends in sparse graphs) are never reported or reinforced
+//	- Nearest-neighbour candidate lists to speed up tour construction on large graphs
+//	- Optional pheromone seeding from a greedy nearest-neighbour tour
+//	- Closed tours (cycles) by default, or open tours for path problems
+//	- Pluggable pheromone deposit strategies (elitist, rank-based or custom)
+//	- Pheromone restarts on stagnation, optionally biased toward the best tour
+//	- Pluggable heuristic function replacing inverse-distance visibility
+//	- Directed mode for asymmetric distance matrices with one-directional deposits
+//	- Roulette-wheel or tournament selection of each ant's next node
+//	- Ant Colony System pseudo-random proportional rule via Q0
+//	- Multiple independent colonies that periodically share the global-best tour
+//	- Adaptive evaporation through a per-epoch evaporation schedule
 //
 // Author:      Braiden Gole
 // Created:     July 29, 2025
@@ -22,34 +42,110 @@
 package antcolonyoptimization
 
 import (
+	"context"
+	"errors"
 	"math"
 	"math/rand"
+	"runtime"
+	"sync"
+	"time"
 
 	ant "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Ant"
 	graph "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Graph"
 	pheromone "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Pheromone"
 )
 
+// ErrNoValidTour is returned by SolveContext when no ant managed to construct a complete,
+// finite-cost cycle during the whole run, for example on a graph without a Hamiltonian cycle.
+var ErrNoValidTour = errors.New("ant colony optimization: no valid tour found")
+
+// DepositMode selects which tours deposit pheromone at the end of each epoch.
+type DepositMode int
+
+const (
+	// DepositAllAnts has every ant deposit pheromone along its own tour.
+	DepositAllAnts DepositMode = iota
+
+	// DepositIterationBest has only the best tour of the current epoch deposit pheromone.
+	DepositIterationBest
+
+	// DepositGlobalBest has only the best tour found so far deposit pheromone.
+	DepositGlobalBest
+)
+
+// DefaultExchangeInterval is the number of epochs between best-tour exchanges in
+// SolveMultiColony when ExchangeInterval is not set.
+const DefaultExchangeInterval int = 10
+
 // AntColonyOptimizer encapsulates the parameters and state needed to run the
 // Ant Colony Optimization algorithm.
 //
-// ProblemGraph    - the graph representing the problem to be solved
-// PheromoneLevels - matrix tracking pheromone intensities on graph edges
-// Alpha           - influence weight of pheromone strength on path selection
-// Beta            - influence weight of heuristic visibility (inverse distance) on path selection
-// EvaporateRate   - rate at which pheromone evaporates each epoch (decay factor)
-// DepositFactor   - scaling factor for pheromone deposited by ants after tours
-// NumberOfAnts    - number of ants constructing tours each epoch
-// NumberOfEpochs  - number of iterations to run the optimization process
+// ProblemGraph        - the graph representing the problem to be solved
+// PheromoneLevels     - matrix tracking pheromone intensities on graph edges
+// Alpha               - influence weight of pheromone strength on path selection
+// Beta                - influence weight of heuristic visibility (inverse distance) on path selection
+// EvaporateRate       - rate at which pheromone evaporates each epoch (decay factor)
+// DepositFactor       - scaling factor for pheromone deposited by ants after tours
+// NumberOfAnts        - number of ants constructing tours each epoch
+// NumberOfEpochs      - number of iterations to run the optimization process
+// NumberOfWorkers     - number of goroutines constructing tours concurrently (1 or less runs sequentially)
+// TauMin              - lower pheromone bound applied after each update (Max-Min Ant System)
+// TauMax              - upper pheromone bound applied after each update; bounds are disabled when 0
+// DepositMode         - which tours deposit pheromone each epoch (all ants by default)
+// DepositStrategy     - custom pheromone update; when set it replaces DepositMode
+// EarlyStoppingEpochs - stop once the best cost has not improved for this many consecutive epochs (0 disables)
+// UseLocalSearch      - refine every constructed tour with a 2-opt pass before it is evaluated
+// OnEpoch             - optional hook called after every epoch with the best-so-far and epoch-best costs (+Inf if none)
+// CandidateListSize   - number of nearest neighbours each ant considers before scanning all nodes (0 disables)
+// SeedWithGreedyTour  - initialize pheromones from a greedy nearest-neighbour tour when a run starts
+// ReturnToStart       - close every tour with an edge back to its start node (true by default)
+// StagnationLimit     - restart pheromones once the best cost has not improved for this many epochs (0 disables)
+// RestartBiasToBest   - after a restart, deposit DepositFactor / bestCost along the best tour so far
+// OnRestart           - optional hook called with the epoch after every pheromone restart
+// Heuristic           - optional desirability of moving between two nodes, called concurrently by workers (nil uses 1 / distance)
+// Directed            - treat the problem as asymmetric: pheromone is deposited only along the travelled direction
+// SelectionMode       - how ants pick their next node: roulette wheel (default) or tournament
+// TournamentSize      - nodes sampled per move in tournament mode (0 or less uses ant.DefaultTournamentSize)
+// Q0                  - probability that a move greedily takes the best edge instead of the selection mode (0 disables)
+// ExchangeInterval    - epochs between best-tour exchanges in SolveMultiColony (0 or less uses DefaultExchangeInterval)
+// EvaporationSchedule - optional evaporation rate per epoch, replacing the constant EvaporateRate when set
 type AntColonyOptimizer struct {
-	ProblemGraph    *graph.Graph
-	PheromoneLevels *pheromone.PheromoneMatrix
-	Alpha           float64
-	Beta            float64
-	EvaporateRate   float64
-	DepositFactor   float64
-	NumberOfAnts    int
-	NumberOfEpochs  int
+	ProblemGraph        *graph.Graph
+	PheromoneLevels     *pheromone.PheromoneMatrix
+	Alpha               float64
+	Beta                float64
+	EvaporateRate       float64
+	DepositFactor       float64
+	NumberOfAnts        int
+	NumberOfEpochs      int
+	NumberOfWorkers     int
+	TauMin              float64
+	TauMax              float64
+	DepositMode         DepositMode
+	DepositStrategy     DepositStrategy
+	EarlyStoppingEpochs int
+	UseLocalSearch      bool
+	OnEpoch             func(epoch int, bestCost float64, iterationBestCost float64)
+	CandidateListSize   int
+	SeedWithGreedyTour  bool
+	ReturnToStart       bool
+	StagnationLimit     int
+	RestartBiasToBest   bool
+	OnRestart           func(epoch int)
+	Heuristic           func(from int, to int) float64
+	Directed            bool
+	SelectionMode       ant.SelectionMode
+	TournamentSize      int
+	Q0                  float64
+	ExchangeInterval    int
+	EvaporationSchedule func(epoch int) float64
+	random              *rand.Rand
+	candidateLists      [][]int
+	candidateListSize   int
+	candidateVersion    uint64
+	firstEpoch          int
+	onTours             func(ants []*ant.Ant)
+	onBestCost          func(bestCost float64)
 }
 
 // NewAntColonyOptimizer initializes and returns a new AntColonyOptimizer instance.
@@ -64,10 +160,32 @@ type AntColonyOptimizer struct {
 //	antCount       - number of ants per epoch
 //	epochCount     - number of epochs (iterations) to run
 //
+// The worker pool is sized to runtime.NumCPU(); set NumberOfWorkers to 1 to construct
+// tours sequentially.
+//
 // Returns:
 //
 //	Pointer to a fully initialized AntColonyOptimizer.
 func NewAntColonyOptimizer(graph *graph.Graph, alpha, beta, evaporationRate, depositFactor float64, antCount, epochCount int) *AntColonyOptimizer {
+	return NewSeededAntColonyOptimizer(graph, alpha, beta, evaporationRate, depositFactor, antCount, epochCount,
+		time.Now().UnixNano())
+}
+
+// NewSeededAntColonyOptimizer initializes and returns a new AntColonyOptimizer whose random
+// decisions (start nodes and every ant's path selection) all derive from the given seed.
+// Two optimizers created with the same seed and parameters produce identical tours from
+// Solve, regardless of the number of workers.
+//
+// Parameters:
+//
+//	graph, alpha, beta, evaporationRate, depositFactor, antCount, epochCount - as for NewAntColonyOptimizer
+//	seed - seed for the optimizer's random number generator
+//
+// Returns:
+//
+//	Pointer to a fully initialized AntColonyOptimizer.
+func NewSeededAntColonyOptimizer(graph *graph.Graph, alpha, beta, evaporationRate, depositFactor float64, antCount, epochCount int,
+	seed int64) *AntColonyOptimizer {
 	var pheromones *pheromone.PheromoneMatrix = pheromone.NewPheromoneMatrix(graph.NumberOfNodes, 1.0)
 
 	return &AntColonyOptimizer{
@@ -79,7 +197,247 @@ func NewAntColonyOptimizer(graph *graph.Graph, alpha, beta, evaporationRate, dep
 		DepositFactor:   depositFactor,
 		NumberOfAnts:    antCount,
 		NumberOfEpochs:  epochCount,
+		NumberOfWorkers: runtime.NumCPU(),
+		ReturnToStart:   true,
+		random:          rand.New(rand.NewSource(seed)),
+	}
+}
+
+// candidates returns the nearest-neighbour candidate lists for the configured
+// CandidateListSize, computing them once and recomputing only if the size changes or
+// the graph's distances were changed since. It returns nil while candidate lists are disabled.
+func (antColonyOptimizer *AntColonyOptimizer) candidates() [][]int {
+	if antColonyOptimizer.CandidateListSize <= 0 {
+		return nil
+	}
+
+	if antColonyOptimizer.candidateLists == nil || antColonyOptimizer.candidateListSize != antColonyOptimizer.CandidateListSize ||
+		antColonyOptimizer.candidateVersion != antColonyOptimizer.ProblemGraph.Version() {
+		antColonyOptimizer.candidateLists = antColonyOptimizer.ProblemGraph.NearestNeighbors(antColonyOptimizer.CandidateListSize)
+		antColonyOptimizer.candidateListSize = antColonyOptimizer.CandidateListSize
+		antColonyOptimizer.candidateVersion = antColonyOptimizer.ProblemGraph.Version()
+	}
+
+	return antColonyOptimizer.candidateLists
+}
+
+// constructTours creates the ants for one epoch and has each of them build a tour.
+//
+// Every ant receives its own random number generator and start node, both drawn from the
+// optimizer's generator before any tour is built, so the ants can run concurrently
+// without sharing random state and the results are reproducible for a given seed.
+// When more than one worker is configured, the tours are built by a pool of goroutines;
+// the pheromone matrix is only read during construction, so no locking is required.
+//
+// Returns:
+//
+//	Slice of ants, in creation order, each holding a completed tour.
+func (antColonyOptimizer *AntColonyOptimizer) constructTours() []*ant.Ant {
+	var ants []*ant.Ant = make([]*ant.Ant, antColonyOptimizer.NumberOfAnts)
+	var startNodes []int = make([]int, antColonyOptimizer.NumberOfAnts)
+	var candidates [][]int = antColonyOptimizer.candidates()
+
+	for index := range ants {
+		ants[index] = ant.NewAnt(antColonyOptimizer.ProblemGraph, antColonyOptimizer.PheromoneLevels,
+			antColonyOptimizer.Alpha, antColonyOptimizer.Beta,
+			rand.New(rand.NewSource(antColonyOptimizer.random.Int63())))
+		ants[index].SetCandidateLists(candidates)
+		ants[index].ReturnToStart = antColonyOptimizer.ReturnToStart
+		ants[index].Heuristic = antColonyOptimizer.Heuristic
+		ants[index].Selection = antColonyOptimizer.SelectionMode
+		ants[index].TournamentSize = antColonyOptimizer.TournamentSize
+		ants[index].Q0 = antColonyOptimizer.Q0
+
+		// Construct each tour starting from a random node.
+		startNodes[index] = antColonyOptimizer.random.Intn(antColonyOptimizer.ProblemGraph.NumberOfNodes)
+	}
+
+	// Sequential construction.
+	if antColonyOptimizer.NumberOfWorkers <= 1 {
+		for index, currentAnt := range ants {
+			antColonyOptimizer.buildTour(currentAnt, startNodes[index])
+		}
+
+		return ants
+	}
+
+	// Concurrent construction: workers pull ant indices from a shared channel.
+	var jobs chan int = make(chan int)
+	var waitGroup sync.WaitGroup
+
+	for worker := 0; worker < antColonyOptimizer.NumberOfWorkers; worker++ {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			for index := range jobs {
+				antColonyOptimizer.buildTour(ants[index], startNodes[index])
+			}
+		}()
+	}
+
+	for index := range ants {
+		jobs <- index
+	}
+
+	close(jobs)
+	waitGroup.Wait()
+
+	return ants
+}
+
+// buildTour has the ant construct a tour from the start node and, when local search is
+// enabled, replaces the ant's valid tour and cost with the 2-opt refinement of that tour.
+func (antColonyOptimizer *AntColonyOptimizer) buildTour(currentAnt *ant.Ant, startNode int) {
+	if !currentAnt.ConstructTour(startNode) {
+		return
+	}
+
+	if antColonyOptimizer.UseLocalSearch {
+		currentAnt.PathTaken, currentAnt.TotalCost = TwoOpt(currentAnt.PathTaken, antColonyOptimizer.ProblemGraph)
+	}
+}
+
+// tourCost sums the distances along consecutive nodes of the tour.
+func tourCost(tour []int, problemGraph *graph.Graph) float64 {
+	var cost float64 = 0.0
+
+	for index := 0; index < len(tour)-1; index++ {
+		cost += problemGraph.DistanceBetween(tour[index], tour[index+1])
+	}
+
+	return cost
+}
+
+// TwoOpt applies 2-opt local search to a tour, repeatedly reversing the segment between
+// two positions whenever that lowers the total cost, until no improving move remains.
+//
+// The first and last positions of the tour are kept fixed, so a closed tour stays closed
+// at the same start node, and an open tour keeps both of its endpoints. The cost of the
+// reversed segment is re-evaluated in its new direction, so asymmetric distance matrices
+// are handled correctly.
+//
+// Parameters:
+//
+//	tour         - slice of node indices to refine; it is not modified
+//	problemGraph - the graph supplying the distances
+//
+// Returns:
+//
+//	refinedTour - a new slice holding the improved tour
+//	refinedCost - total cost (distance) of the improved tour
+func TwoOpt(tour []int, problemGraph *graph.Graph) ([]int, float64) {
+	const EPSILON float64 = 1e-10
+
+	var refinedTour []int = append([]int(nil), tour...)
+
+	var improved bool = true
+
+	var before float64 = 0.0
+	var after float64 = 0.0
+
+	for improved {
+		improved = false
+
+		for start := 1; start < len(refinedTour)-2; start++ {
+			for end := start + 1; end < len(refinedTour)-1; end++ {
+				// Cost of the affected stretch as it is now...
+				before = tourCost(refinedTour[start-1:end+2], problemGraph)
+
+				// ...and with the segment [start, end] reversed.
+				after = problemGraph.DistanceBetween(refinedTour[start-1], refinedTour[end]) +
+					problemGraph.DistanceBetween(refinedTour[start], refinedTour[end+1])
+
+				for index := end; index > start; index-- {
+					after += problemGraph.DistanceBetween(refinedTour[index], refinedTour[index-1])
+				}
+
+				if after < before-EPSILON {
+					for left, right := start, end; left < right; left, right = left+1, right-1 {
+						refinedTour[left], refinedTour[right] = refinedTour[right], refinedTour[left]
+					}
+
+					improved = true
+				}
+			}
+		}
+	}
+
+	return refinedTour, tourCost(refinedTour, problemGraph)
+}
+
+// ClampPheromones limits every pheromone level to [TauMin, TauMax], as in the Max-Min Ant
+// System. It does nothing while TauMax is 0, which leaves the bounds disabled.
+func (antColonyOptimizer *AntColonyOptimizer) ClampPheromones() {
+	if antColonyOptimizer.TauMax <= 0 {
+		return
+	}
+
+	antColonyOptimizer.PheromoneLevels.Clamp(antColonyOptimizer.TauMin, antColonyOptimizer.TauMax)
+}
+
+// SeedPheromonesFromGreedyTour replaces the uniform starting pheromone with levels derived from
+// a greedy nearest-neighbour tour starting at node 0. Every edge is set to 1 / greedyCost and
+// the greedy tour's edges additionally receive DepositFactor / greedyCost, so good edges are
+// favored from the first epoch instead of being discovered over many. When ReturnToStart is
+// false the open greedy path is used instead, so the closing edge is neither required nor
+// rewarded. Pheromone bounds are applied afterwards. Nothing changes if the greedy tour is
+// incomplete.
+//
+// Returns:
+//
+//	True if the pheromone matrix was seeded; false if no finite greedy tour exists.
+func (antColonyOptimizer *AntColonyOptimizer) SeedPheromonesFromGreedyTour() bool {
+	var greedyTour []int
+	var greedyCost float64
+
+	if antColonyOptimizer.ReturnToStart {
+		greedyTour, greedyCost = antColonyOptimizer.ProblemGraph.GreedyTour(0)
+	} else {
+		greedyTour, greedyCost = antColonyOptimizer.ProblemGraph.GreedyPath(0)
+	}
+
+	if math.IsInf(greedyCost, 0) || greedyCost <= 0 {
+		return false
+	}
+
+	for row := range antColonyOptimizer.PheromoneLevels.Values {
+		for column := range antColonyOptimizer.PheromoneLevels.Values[row] {
+			antColonyOptimizer.PheromoneLevels.Values[row][column] = 1.0 / greedyCost
+		}
+	}
+
+	antColonyOptimizer.PheromoneLevels.DepositPheromones(greedyTour, antColonyOptimizer.DepositFactor/greedyCost)
+	antColonyOptimizer.ClampPheromones()
+
+	return true
+}
+
+// restartPheromones restores the pheromone matrix to the given starting levels, as in Max-Min
+// Ant System restarts, so the colony can escape a local optimum. When RestartBiasToBest is set
+// and a best tour exists, that tour additionally receives DepositFactor / bestTourCost. Pheromone
+// bounds are applied afterwards.
+func (antColonyOptimizer *AntColonyOptimizer) restartPheromones(initialPheromones *pheromone.PheromoneMatrix, bestTour []int,
+	bestTourCost float64) {
+	antColonyOptimizer.PheromoneLevels = initialPheromones.Clone()
+
+	if antColonyOptimizer.RestartBiasToBest && len(bestTour) > 0 {
+		antColonyOptimizer.PheromoneLevels.DepositPheromones(bestTour, antColonyOptimizer.DepositFactor/bestTourCost)
 	}
+
+	antColonyOptimizer.ClampPheromones()
+}
+
+// evaporationRate returns the evaporation rate for the given epoch of the current run: the
+// value of EvaporationSchedule when set, or the constant EvaporateRate. The schedule receives
+// epochs counted from the start of the whole solve, including earlier SolveMultiColony rounds.
+func (antColonyOptimizer *AntColonyOptimizer) evaporationRate(epoch int) float64 {
+	if antColonyOptimizer.EvaporationSchedule != nil {
+		return antColonyOptimizer.EvaporationSchedule(antColonyOptimizer.firstEpoch + epoch)
+	}
+
+	return antColonyOptimizer.EvaporateRate
 }
 
 // Solve executes the ACO algorithm over the configured number of epochs,
@@ -88,40 +446,346 @@ func NewAntColonyOptimizer(graph *graph.Graph, alpha, beta, evaporationRate, dep
 //
 // Returns:
 //
-//	bestTour     - slice of node indices representing the best tour found
-//	bestTourCost - total cost (distance) of the best tour
+//	bestTour     - slice of node indices representing the best tour found (empty if none was valid)
+//	bestTourCost - total cost (distance) of the best tour (+Inf if none was valid)
 func (antColonyOptimizer *AntColonyOptimizer) Solve() ([]int, float64) {
+	bestTour, bestTourCost, _ := antColonyOptimizer.SolveContext(context.Background())
+
+	return bestTour, bestTourCost
+}
+
+// SolveWithHistory executes the ACO algorithm like Solve and additionally records the best
+// cost found so far at the end of every epoch, so convergence can be plotted. A user-supplied
+// OnEpoch hook is left in place and still called.
+//
+// Returns:
+//
+//	bestTour     - slice of node indices representing the best tour found (empty if none was valid)
+//	bestTourCost - total cost (distance) of the best tour (+Inf if none was valid)
+//	history      - best cost after each epoch run; one entry per epoch unless the run stopped early
+func (antColonyOptimizer *AntColonyOptimizer) SolveWithHistory() ([]int, float64, []float64) {
+	var history []float64 = []float64{}
+
+	defer func() {
+		antColonyOptimizer.onBestCost = nil
+	}()
+
+	antColonyOptimizer.onBestCost = func(bestCost float64) {
+		history = append(history, bestCost)
+	}
+
+	bestTour, bestTourCost, _ := antColonyOptimizer.SolveContext(context.Background())
+
+	return bestTour, bestTourCost, history
+}
+
+// SolveVerbose executes the ACO algorithm like Solve and additionally returns the tour of every
+// ant from the last epoch run, so the whole final population can be inspected or visualized.
+// The tours are copies that the caller may modify freely. They are listed in ant creation order
+// and include 2-opt refinements when local search is enabled; an ant that hit a dead end
+// contributes the partial path it walked.
+//
+// Returns:
+//
+//	bestTour       - slice of node indices representing the best tour found (empty if none was valid)
+//	bestCost       - total cost (distance) of the best tour (+Inf if none was valid)
+//	lastEpochTours - one tour per ant of the last epoch run (empty if no epoch was run)
+func (antColonyOptimizer *AntColonyOptimizer) SolveVerbose() ([]int, float64, [][]int) {
+	var lastAnts []*ant.Ant
+
+	defer func() {
+		antColonyOptimizer.onTours = nil
+	}()
+
+	// Ants are created afresh every epoch, so only the latest slice needs to be kept.
+	antColonyOptimizer.onTours = func(ants []*ant.Ant) {
+		lastAnts = ants
+	}
+
+	bestTour, bestCost, _ := antColonyOptimizer.SolveContext(context.Background())
+
+	var lastEpochTours [][]int = make([][]int, len(lastAnts))
+
+	for index, insect := range lastAnts {
+		lastEpochTours[index] = append([]int(nil), insect.PathTaken...)
+	}
+
+	return bestTour, bestCost, lastEpochTours
+}
+
+// SolveContext executes the ACO algorithm like Solve, but checks the context between
+// epochs. When the context is cancelled, the best tour found so far is returned together
+// with the context's error. The run also ends early, without error, once the best cost has
+// not improved for EarlyStoppingEpochs consecutive epochs (when that option is set).
+// Incomplete tours are ignored; if no valid tour is found at all, ErrNoValidTour is returned.
+// When SeedWithGreedyTour is set, the pheromone matrix is seeded before the first epoch.
+// Distances are always read in the direction of travel; Directed additionally makes every
+// pheromone update one-directional.
+// When EvaporationSchedule is set, it supplies the evaporation rate of every epoch, for example
+// to start low for exploitation and rise to encourage exploration.
+// When StagnationLimit is set, the pheromone matrix is restored to its levels at the start of
+// the run whenever the best cost has not improved for that many epochs since the last
+// improvement or restart; the best tour found so far is kept.
+//
+// Parameters:
+//
+//	ctx - controls cancellation of the run
+//
+// Returns:
+//
+//	bestTour     - slice of node indices representing the best tour found so far
+//	bestTourCost - total cost (distance) of the best tour
+//	err          - ctx.Err() if the run was cancelled, ErrNoValidTour if no tour was valid, nil otherwise
+func (antColonyOptimizer *AntColonyOptimizer) SolveContext(ctx context.Context) ([]int, float64, error) {
 	var bestTour []int = []int{}
-	var bestTourCost float64 = math.MaxFloat64
+	var bestTourCost float64 = math.Inf(1)
+	var iterationBestCost float64 = math.Inf(1)
 
 	var ants []*ant.Ant
-	var currentAnt *ant.Ant
+	var iterationBest *ant.Ant
+
+	var epochsWithoutImprovement int = 0
+	var epochsSinceProgress int = 0
+	var improved bool = false
+
+	var initialPheromones *pheromone.PheromoneMatrix
+
+	antColonyOptimizer.PheromoneLevels.Directed = antColonyOptimizer.Directed
+
+	if antColonyOptimizer.SeedWithGreedyTour {
+		antColonyOptimizer.SeedPheromonesFromGreedyTour()
+	}
+
+	// Remember the starting levels so stagnation restarts can return to them.
+	if antColonyOptimizer.StagnationLimit > 0 {
+		initialPheromones = antColonyOptimizer.PheromoneLevels.Clone()
+	}
 
 	for epoch := 0; epoch < antColonyOptimizer.NumberOfEpochs; epoch++ {
-		ants = make([]*ant.Ant, antColonyOptimizer.NumberOfAnts)
+		// Stop between epochs if the caller cancelled the run.
+		select {
+		case <-ctx.Done():
+			return bestTour, bestTourCost, ctx.Err()
+		default:
+		}
+
+		ants = antColonyOptimizer.constructTours()
+		iterationBest = nil
 
-		for index := 0; index < antColonyOptimizer.NumberOfAnts; index++ {
-			currentAnt = ant.NewAnt(antColonyOptimizer.ProblemGraph, antColonyOptimizer.PheromoneLevels,
-				antColonyOptimizer.Alpha, antColonyOptimizer.Beta)
+		if antColonyOptimizer.onTours != nil {
+			antColonyOptimizer.onTours(ants)
+		}
 
-			// Construct a tour starting from a random node.
-			currentAnt.ConstructTour(rand.Intn(antColonyOptimizer.ProblemGraph.NumberOfNodes))
+		improved = false
 
-			ants[index] = currentAnt
+		// Aggregate in creation order so the outcome does not depend on goroutine scheduling.
+		for _, currentAnt := range ants {
+			// Dead ends and unusable edges never count as solutions.
+			if !currentAnt.ValidTour {
+				continue
+			}
+
+			if iterationBest == nil || currentAnt.TotalCost < iterationBest.TotalCost {
+				iterationBest = currentAnt
+			}
 
 			// Update best solution found so far.
 			if currentAnt.TotalCost < bestTourCost {
 				bestTourCost = currentAnt.TotalCost
 				bestTour = append([]int(nil), currentAnt.PathTaken...)
+				improved = true
 			}
 		}
 
 		// Evaporate pheromones to simulate natural decay.
-		antColonyOptimizer.PheromoneLevels.Evaporate(antColonyOptimizer.EvaporateRate)
+		antColonyOptimizer.PheromoneLevels.Evaporate(antColonyOptimizer.evaporationRate(epoch))
+
+		// Deposit pheromones based on the selected tours, reinforcing shorter paths.
+		switch {
+		case antColonyOptimizer.DepositStrategy != nil:
+			var validAnts []*ant.Ant = make([]*ant.Ant, 0, len(ants))
+
+			for _, insect := range ants {
+				if insect.ValidTour {
+					validAnts = append(validAnts, insect)
+				}
+			}
+
+			antColonyOptimizer.DepositStrategy.Deposit(antColonyOptimizer.PheromoneLevels, validAnts, bestTour, bestTourCost,
+				antColonyOptimizer.DepositFactor)
+		case antColonyOptimizer.DepositMode == DepositIterationBest:
+			if iterationBest != nil {
+				antColonyOptimizer.PheromoneLevels.DepositPheromones(iterationBest.PathTaken,
+					antColonyOptimizer.DepositFactor/iterationBest.TotalCost)
+			}
+		case antColonyOptimizer.DepositMode == DepositGlobalBest:
+			antColonyOptimizer.PheromoneLevels.DepositPheromones(bestTour, antColonyOptimizer.DepositFactor/bestTourCost)
+		default:
+			for _, insect := range ants {
+				if insect.ValidTour {
+					antColonyOptimizer.PheromoneLevels.DepositPheromones(insect.PathTaken, antColonyOptimizer.DepositFactor/insect.TotalCost)
+				}
+			}
+		}
+
+		// Keep pheromone levels within the Max-Min bounds, if configured.
+		antColonyOptimizer.ClampPheromones()
+
+		// Report progress to the caller, if requested.
+		if antColonyOptimizer.OnEpoch != nil {
+			iterationBestCost = math.Inf(1)
+
+			if iterationBest != nil {
+				iterationBestCost = iterationBest.TotalCost
+			}
+
+			antColonyOptimizer.OnEpoch(epoch, bestTourCost, iterationBestCost)
+		}
+
+		if antColonyOptimizer.onBestCost != nil {
+			antColonyOptimizer.onBestCost(bestTourCost)
+		}
+
+		// Stop once the best cost has stagnated for too long.
+		if improved {
+			epochsWithoutImprovement = 0
+			epochsSinceProgress = 0
+		} else {
+			epochsWithoutImprovement++
+			epochsSinceProgress++
+		}
+
+		if antColonyOptimizer.EarlyStoppingEpochs > 0 && epochsWithoutImprovement >= antColonyOptimizer.EarlyStoppingEpochs {
+			break
+		}
+
+		// Restart pheromones to escape a local optimum once progress has stalled.
+		if antColonyOptimizer.StagnationLimit > 0 && epochsSinceProgress >= antColonyOptimizer.StagnationLimit {
+			antColonyOptimizer.restartPheromones(initialPheromones, bestTour, bestTourCost)
+			epochsSinceProgress = 0
+
+			if antColonyOptimizer.OnRestart != nil {
+				antColonyOptimizer.OnRestart(epoch)
+			}
+		}
+	}
+
+	if len(bestTour) == 0 {
+		return bestTour, bestTourCost, ErrNoValidTour
+	}
+
+	return bestTour, bestTourCost, nil
+}
+
+// SolveMultiColony runs several independent colonies on the problem and returns the best tour
+// found by any of them. Every colony copies the optimizer's configuration and pheromone matrix
+// but draws its own seed from the optimizer's generator, so colonies explore differently while
+// the whole run stays reproducible for a given seed.
+//
+// The NumberOfEpochs are run in rounds of ExchangeInterval epochs. After each round the best
+// tour found so far by any colony is reinforced in every colony's pheromone matrix with
+// DepositFactor / bestCost, followed by the pheromone bounds, so good edges spread between
+// colonies while each keeps its own search history. When more than one worker is configured
+// the colonies run concurrently, each constructing its tours sequentially. Greedy seeding is
+// applied once, before the first round; OnEpoch and OnRestart are not called, and stagnation
+// restarts return a colony to its levels at the start of the current round.
+//
+// Parameters:
+//
+//	colonies - number of colonies; values below 1 run a single colony
+//
+// Returns:
+//
+//	bestTour     - slice of node indices representing the best tour found (empty if none was valid)
+//	bestTourCost - total cost (distance) of the best tour (+Inf if none was valid)
+func (antColonyOptimizer *AntColonyOptimizer) SolveMultiColony(colonies int) ([]int, float64) {
+	var bestTour []int = []int{}
+	var bestTourCost float64 = math.Inf(1)
+
+	var exchangeInterval int = antColonyOptimizer.ExchangeInterval
+
+	if exchangeInterval <= 0 {
+		exchangeInterval = DefaultExchangeInterval
+	}
+
+	if colonies < 1 {
+		colonies = 1
+	}
+
+	var parallel bool = antColonyOptimizer.NumberOfWorkers > 1 && colonies > 1
+
+	var initialPheromones *pheromone.PheromoneMatrix = antColonyOptimizer.PheromoneLevels.Clone()
+
+	initialPheromones.Directed = antColonyOptimizer.Directed
+
+	if antColonyOptimizer.SeedWithGreedyTour {
+		var seeder AntColonyOptimizer = *antColonyOptimizer
+
+		seeder.PheromoneLevels = initialPheromones
+		seeder.SeedPheromonesFromGreedyTour()
+	}
+
+	var colonyOptimizers []*AntColonyOptimizer = make([]*AntColonyOptimizer, colonies)
+
+	for index := range colonyOptimizers {
+		var colony AntColonyOptimizer = *antColonyOptimizer
+
+		colony.PheromoneLevels = initialPheromones.Clone()
+		colony.SeedWithGreedyTour = false
+		colony.OnEpoch = nil
+		colony.OnRestart = nil
+		colony.random = rand.New(rand.NewSource(antColonyOptimizer.random.Int63()))
+
+		if parallel {
+			colony.NumberOfWorkers = 1
+		}
+
+		colonyOptimizers[index] = &colony
+	}
+
+	var tours [][]int = make([][]int, colonies)
+	var costs []float64 = make([]float64, colonies)
+
+	for epoch := 0; epoch < antColonyOptimizer.NumberOfEpochs; epoch += exchangeInterval {
+		var waitGroup sync.WaitGroup
+
+		for index, colony := range colonyOptimizers {
+			colony.NumberOfEpochs = min(exchangeInterval, antColonyOptimizer.NumberOfEpochs-epoch)
+			colony.firstEpoch = epoch
+
+			if !parallel {
+				tours[index], costs[index], _ = colony.SolveContext(context.Background())
+
+				continue
+			}
+
+			waitGroup.Add(1)
+
+			go func(index int, colony *AntColonyOptimizer) {
+				defer waitGroup.Done()
+
+				tours[index], costs[index], _ = colony.SolveContext(context.Background())
+			}(index, colony)
+		}
+
+		waitGroup.Wait()
+
+		// Compare in colony order so ties are resolved independently of scheduling.
+		for index := range colonyOptimizers {
+			if costs[index] < bestTourCost {
+				bestTourCost = costs[index]
+				bestTour = tours[index]
+			}
+		}
+
+		if len(bestTour) == 0 {
+			continue
+		}
 
-		// Deposit pheromones based on ant tours, reinforcing shorter paths.
-		for _, insect := range ants {
-			antColonyOptimizer.PheromoneLevels.DepositPheromones(insect.PathTaken, antColonyOptimizer.DepositFactor/insect.TotalCost)
+		// Share the global-best tour with every colony.
+		for _, colony := range colonyOptimizers {
+			colony.PheromoneLevels.DepositPheromones(bestTour, colony.DepositFactor/bestTourCost)
+			colony.ClampPheromones()
 		}
 	}
 
@@ -10,10 +10,28 @@
 //	The tests in this file cover key scenarios, including:
 //
 //	- Basic functionality on known distance matrices
-//	- Deterministic consistency for identical inputs
+//	- Deterministic, seed-reproducible results for identical inputs
 //	- Edge cases such as single-node and zero-distance graphs
 //	- Handling of full pheromone evaporation and sparse graphs
 //	- Uniform edge weight graphs and heuristic/pheromone balance
+//	- Concurrent tour construction across a worker pool
+//	- Max-Min pheromone bounds and best-tour-only deposit modes
+//	- Context cancellation and early stopping
+//	- 2-opt local search refinement
+//	- Per-epoch progress reporting and best-cost history
+//	- Every ant's tour from the final epoch
+//	- Graphs with missing edges and no Hamiltonian cycle
+//	- Nearest-neighbour candidate lists, rebuilt after the graph changes
+//	- Pheromone seeding from a greedy nearest-neighbour tour, or the open path for open tours
+//	- Open tours that do not return to the start node
+//	- Elitist and rank-based pheromone deposit strategies
+//	- Pheromone restarts on stagnation
+//	- Custom heuristic functions replacing inverse distance
+//	- Asymmetric distance matrices in directed mode
+//	- Tournament selection of the next node
+//	- Greedy Ant Colony System state transitions with q0
+//	- Multiple colonies sharing their global-best tour
+//	- Per-epoch evaporation schedules
 //
 //	All tests are written using Go’s built-in "testing" package.
 //
@@ -29,20 +47,66 @@
 //	✅ TestHighEvaporationRate
 //	✅ TestSparseGraph
 //	✅ TestAllEqualDistances
+//	✅ TestParallelSolveProducesValidTours
+//	✅ TestPheromoneBoundsHoldAcrossEpochs
+//	✅ TestBestTourDepositModes
+//	✅ TestSolveContextCancellation
+//	✅ TestEarlyStopping
+//	✅ TestTwoOptNeverWorsensTour
+//	✅ TestTwoOptRemovesCrossing
+//	✅ TestSolveWithLocalSearch
+//	✅ TestOnEpochReportsNonIncreasingBestCost
+//	✅ TestSolveWithHistory
+//	✅ TestSolveVerbose
+//	✅ TestNoValidTour
+//	✅ TestCandidateListsProduceValidTours
+//	✅ TestCandidateListsFollowGraphChanges
+//	✅ TestGreedySeedingConvergesFaster
+//	✅ TestGreedySeedingOpenTour
+//	✅ TestOpenTour
+//	✅ TestElitistDepositReinforcesBestTour
+//	✅ TestRankBasedDepositOnlyTopAnts
+//	✅ TestSolveWithDepositStrategy
+//	✅ TestStagnationRestart
+//	✅ TestStagnationRestartBiasedToBest
+//	✅ TestCustomHeuristicChangesSelection
+//	✅ TestSolveWithCustomHeuristic
+//	✅ TestDirectedAsymmetricTour
+//	✅ TestTournamentSelectionProducesValidTours
+//	✅ TestFullyGreedyQ0
+//	✅ TestMultiColonyNoWorseThanSingleColony
+//	✅ TestEvaporationScheduleAppliedPerEpoch
+//
+// Benchmarks:
+//
+//	⏱ BenchmarkSolveSequential
+//	⏱ BenchmarkSolveParallel
+//	⏱ BenchmarkSolveFullScan
+//	⏱ BenchmarkSolveCandidateLists
 //
 // Usage:
 //
 //	To run all tests:
 //	$ go test
 //
+//	To run the benchmarks:
+//	$ go test -bench .
+//
 // ===================================================================================
 package antcolonyoptimization
 
 import (
+	"context"
 	"math"
+	"math/rand"
+	"reflect"
+	"runtime"
 	"testing"
+	"time"
 
+	ant "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Ant"
 	graph "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Graph"
+	pheromone "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Pheromone"
 )
 
 // distance matrix is a symmetric 5x5 matrix representing distances between cities.
@@ -73,20 +137,28 @@ func TestBasicACOExecution(test *testing.T) {
 	}
 }
 
-// TestDeterministicRun runs ACO twice on the same graph an compares results.
+// TestDeterministicRun runs ACO twice on the same graph with the same seed and asserts identical results.
 func TestDeterministicRun(test *testing.T) {
 	// Arrange.
-	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
-	var optimizerCompare *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20)
-	var optimizerAgainst *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20)
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(20, 3))
+	var optimizerCompare *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20, 42)
+	var optimizerAgainst *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20, 42)
+
+	// Different worker counts must not affect the outcome.
+	optimizerCompare.NumberOfWorkers = 1
+	optimizerAgainst.NumberOfWorkers = 4
 
 	// Act.
 	compareTour, compareCost := optimizerCompare.Solve()
 	againstTour, againstCost := optimizerAgainst.Solve()
 
 	// Assert.
-	if len(compareTour) != len(againstTour) {
-		test.Errorf("Tours have different lengths: %d vs %d.", len(compareTour), len(againstTour))
+	if !reflect.DeepEqual(compareTour, againstTour) {
+		test.Errorf("Tours differ for the same seed: %v vs %v.", compareTour, againstTour)
+	}
+
+	if compareCost != againstCost {
+		test.Errorf("Tour costs differ for the same seed: %f vs %f.", compareCost, againstCost)
 	}
 
 	if compareCost <= 0 || againstCost <= 0 {
@@ -169,13 +241,17 @@ func TestHighEvaporationRate(test *testing.T) {
 	}
 }
 
-// TestSparseGraph ensures the optimizer handles graph with some unreachable nodes (represented as math.Inf).
+// TestSparseGraph ensures the optimizer handles graph with some missing edges (represented as math.Inf)
+// and only ever reports a valid, finite-cost cycle.
 func TestSparseGraph(test *testing.T) {
 	// Arrange.
+
+	// The only Hamiltonian cycle is 0 -> 1 -> 2 -> 3 -> 0 (or its reverse); the diagonals are missing.
 	var sparseMatrix [][]float64 = [][]float64{
-		{0, 1, math.Inf(1)},
-		{1, 0, 2},
-		{math.Inf(1), 2, 0},
+		{0, 1, math.Inf(1), 4},
+		{1, 0, 2, math.Inf(1)},
+		{math.Inf(1), 2, 0, 3},
+		{4, math.Inf(1), 3, 0},
 	}
 
 	var graph *graph.Graph = graph.NewGraph(sparseMatrix)
@@ -185,12 +261,12 @@ func TestSparseGraph(test *testing.T) {
 	tour, cost := optimizer.Solve()
 
 	// Assert.
-	if len(tour) < 2 {
-		test.Fatalf("Tour is too short: %v.", tour)
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Fatalf("Expected a valid cycle, got %v.", tour)
 	}
 
-	if cost <= 0 || math.IsInf(cost, 1) {
-		test.Errorf("Expected finite positive cost, got %f.", cost)
+	if cost != 10 {
+		test.Errorf("Expected the only finite cycle cost of 10, got %f.", cost)
 	}
 }
 
@@ -219,3 +295,1191 @@ func TestAllEqualDistances(test *testing.T) {
 		test.Errorf("Expected positive cost, got %f.", cost)
 	}
 }
+
+// isValidTour reports whether the tour visits every one of the nodeCount nodes exactly once
+// and returns to its starting node.
+func isValidTour(tour []int, nodeCount int) bool {
+	if len(tour) != nodeCount+1 || tour[0] != tour[len(tour)-1] {
+		return false
+	}
+
+	var visited map[int]bool = make(map[int]bool)
+
+	for _, node := range tour[:nodeCount] {
+		if node < 0 || node >= nodeCount || visited[node] {
+			return false
+		}
+
+		visited[node] = true
+	}
+
+	return true
+}
+
+// randomCoordinateMatrix builds a symmetric distance matrix for nodeCount random points in
+// the unit square, using a fixed seed so benchmarks are comparable across runs.
+func randomCoordinateMatrix(nodeCount int, seed int64) [][]float64 {
+	var random *rand.Rand = rand.New(rand.NewSource(seed))
+
+	var points [][2]float64 = make([][2]float64, nodeCount)
+
+	for index := range points {
+		points[index] = [2]float64{random.Float64(), random.Float64()}
+	}
+
+	var matrix [][]float64 = make([][]float64, nodeCount)
+
+	for row := range matrix {
+		matrix[row] = make([]float64, nodeCount)
+
+		for column := range matrix[row] {
+			matrix[row][column] = math.Hypot(points[row][0]-points[column][0], points[row][1]-points[column][1])
+		}
+	}
+
+	return matrix
+}
+
+// TestParallelSolveProducesValidTours ensures that tours built concurrently are still complete cycles.
+func TestParallelSolveProducesValidTours(test *testing.T) {
+	// Arrange.
+	var matrices [][][]float64 = [][][]float64{distanceMatrix, randomCoordinateMatrix(50, 1)}
+
+	for _, matrix := range matrices {
+		var graph *graph.Graph = graph.NewGraph(matrix)
+		var optimizer *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 16, 10)
+
+		optimizer.NumberOfWorkers = 4
+
+		// Act.
+		tour, cost := optimizer.Solve()
+
+		// Assert.
+		if !isValidTour(tour, graph.NumberOfNodes) {
+			test.Errorf("Expected a valid tour over %d nodes, got %v.", graph.NumberOfNodes, tour)
+		}
+
+		if cost <= 0 || math.IsInf(cost, 1) {
+			test.Errorf("Expected finite positive cost, got %f.", cost)
+		}
+	}
+}
+
+// TestPheromoneBoundsHoldAcrossEpochs runs one epoch at a time and checks that no pheromone
+// entry ever leaves the configured Max-Min bounds, for every deposit mode.
+func TestPheromoneBoundsHoldAcrossEpochs(test *testing.T) {
+	const TAU_MIN float64 = 0.1
+	const TAU_MAX float64 = 2.0
+
+	for _, mode := range []DepositMode{DepositAllAnts, DepositIterationBest, DepositGlobalBest} {
+		// Arrange.
+		var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+		var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 1, 7)
+
+		optimizer.TauMin = TAU_MIN
+		optimizer.TauMax = TAU_MAX
+		optimizer.DepositMode = mode
+
+		for epoch := 0; epoch < 20; epoch++ {
+			// Act.
+			optimizer.Solve()
+
+			// Assert.
+			for row := range optimizer.PheromoneLevels.Values {
+				for column, value := range optimizer.PheromoneLevels.Values[row] {
+					if value < TAU_MIN || value > TAU_MAX {
+						test.Fatalf("Mode %d, epoch %d: pheromone[%d][%d] = %f outside [%f, %f].", mode, epoch, row, column,
+							value, TAU_MIN, TAU_MAX)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestBestTourDepositModes ensures that depositing only along the iteration-best or
+// global-best tour still produces valid, finite-cost tours.
+func TestBestTourDepositModes(test *testing.T) {
+	for _, mode := range []DepositMode{DepositIterationBest, DepositGlobalBest} {
+		// Arrange.
+		var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+		var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20, 11)
+
+		optimizer.DepositMode = mode
+
+		// Act.
+		tour, cost := optimizer.Solve()
+
+		// Assert.
+		if !isValidTour(tour, graph.NumberOfNodes) {
+			test.Errorf("Mode %d: expected a valid tour, got %v.", mode, tour)
+		}
+
+		if cost <= 0 || math.IsInf(cost, 1) {
+			test.Errorf("Mode %d: expected finite positive cost, got %f.", mode, cost)
+		}
+	}
+}
+
+// TestSolveContextCancellation runs an effectively endless optimization under a short deadline
+// and confirms that the best-so-far tour is returned together with the context error.
+func TestSolveContextCancellation(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var optimizer *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, math.MaxInt)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+
+	defer cancel()
+
+	// Act.
+	tour, cost, err := optimizer.SolveContext(ctx)
+
+	// Assert.
+	if err != context.DeadlineExceeded {
+		test.Errorf("Expected context.DeadlineExceeded, got %v.", err)
+	}
+
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Errorf("Expected a valid partial result, got %v.", tour)
+	}
+
+	if cost <= 0 || math.IsInf(cost, 1) {
+		test.Errorf("Expected finite positive cost, got %f.", cost)
+	}
+}
+
+// TestEarlyStopping confirms that a run with a huge epoch budget ends on its own once the
+// best cost stops improving, well before a generous deadline.
+func TestEarlyStopping(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var optimizer *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, math.MaxInt)
+
+	optimizer.EarlyStoppingEpochs = 10
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+
+	defer cancel()
+
+	// Act.
+	tour, _, err := optimizer.SolveContext(ctx)
+
+	// Assert.
+	if err != nil {
+		test.Errorf("Expected early stopping to end the run without error, got %v.", err)
+	}
+
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Errorf("Expected a valid tour, got %v.", tour)
+	}
+}
+
+// TestTwoOptNeverWorsensTour confirms on the 5-city matrix that every refined tour is a valid
+// tour whose cost is less than or equal to the raw tour cost.
+func TestTwoOptNeverWorsensTour(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+
+	var rawTours [][]int = [][]int{
+		{0, 1, 2, 3, 4, 0},
+		{0, 2, 1, 3, 4, 0},
+		{0, 3, 1, 4, 2, 0},
+		{2, 0, 4, 1, 3, 2},
+	}
+
+	for _, rawTour := range rawTours {
+		var rawCost float64 = tourCost(rawTour, graph)
+
+		// Act.
+		refinedTour, refinedCost := TwoOpt(rawTour, graph)
+
+		// Assert.
+		if refinedCost > rawCost {
+			test.Errorf("TwoOpt(%v) cost %f exceeds raw cost %f.", rawTour, refinedCost, rawCost)
+		}
+
+		if !isValidTour(refinedTour, graph.NumberOfNodes) || refinedTour[0] != rawTour[0] {
+			test.Errorf("TwoOpt(%v) = %v; want a valid tour from the same start.", rawTour, refinedTour)
+		}
+	}
+}
+
+// TestTwoOptRemovesCrossing builds a square whose tour crosses itself and confirms 2-opt
+// uncrosses it into the perimeter.
+func TestTwoOptRemovesCrossing(test *testing.T) {
+	// Arrange.
+
+	// Unit square corners 0=(0,0), 1=(1,0), 2=(1,1), 3=(0,1).
+	var diagonal float64 = math.Sqrt2
+
+	var squareMatrix [][]float64 = [][]float64{
+		{0, 1, diagonal, 1},
+		{1, 0, 1, diagonal},
+		{diagonal, 1, 0, 1},
+		{1, diagonal, 1, 0},
+	}
+
+	var graph *graph.Graph = graph.NewGraph(squareMatrix)
+
+	// Act.
+	_, refinedCost := TwoOpt([]int{0, 2, 1, 3, 0}, graph)
+
+	// Assert.
+	if math.Abs(refinedCost-4.0) > 1e-9 {
+		test.Errorf("Expected the uncrossed perimeter cost 4, got %f.", refinedCost)
+	}
+}
+
+// TestSolveWithLocalSearch ensures that enabling local search yields valid tours that are no
+// worse than those found without it for the same seed.
+func TestSolveWithLocalSearch(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(30, 5))
+
+	var plain *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 5, 3)
+	var refined *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 5, 3)
+
+	refined.UseLocalSearch = true
+
+	// Act.
+	_, plainCost := plain.Solve()
+	refinedTour, refinedCost := refined.Solve()
+
+	// Assert.
+	if !isValidTour(refinedTour, graph.NumberOfNodes) {
+		test.Errorf("Expected a valid tour, got %v.", refinedTour)
+	}
+
+	if refinedCost > plainCost {
+		test.Errorf("Expected local search cost %f to be no worse than plain cost %f.", refinedCost, plainCost)
+	}
+}
+
+// TestOnEpochReportsNonIncreasingBestCost records the best cost reported after every epoch and
+// asserts the sequence is complete and monotonically non-increasing.
+func TestOnEpochReportsNonIncreasingBestCost(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(15, 9))
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 5, 25, 9)
+
+	var epochs []int
+	var bestCosts []float64
+
+	optimizer.OnEpoch = func(epoch int, bestCost float64, iterationBestCost float64) {
+		if iterationBestCost < bestCost {
+			test.Errorf("Epoch %d: iteration-best cost %f below best-so-far cost %f.", epoch, iterationBestCost, bestCost)
+		}
+
+		epochs = append(epochs, epoch)
+		bestCosts = append(bestCosts, bestCost)
+	}
+
+	// Act.
+	_, cost := optimizer.Solve()
+
+	// Assert.
+	if len(bestCosts) != optimizer.NumberOfEpochs {
+		test.Fatalf("Expected %d callbacks, got %d.", optimizer.NumberOfEpochs, len(bestCosts))
+	}
+
+	for index := range bestCosts {
+		if epochs[index] != index {
+			test.Errorf("Callback %d reported epoch %d.", index, epochs[index])
+		}
+
+		if index > 0 && bestCosts[index] > bestCosts[index-1] {
+			test.Errorf("Best cost increased from %f to %f at epoch %d.", bestCosts[index-1], bestCosts[index], index)
+		}
+	}
+
+	if bestCosts[len(bestCosts)-1] != cost {
+		test.Errorf("Final reported best cost %f differs from returned cost %f.", bestCosts[len(bestCosts)-1], cost)
+	}
+}
+
+// TestSolveWithHistory checks that one best cost is recorded per epoch, that the history never
+// increases, that it ends at the returned cost, and that a user OnEpoch hook still fires.
+func TestSolveWithHistory(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(20, 3))
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 15, 11)
+
+	var hookCalls int = 0
+
+	optimizer.OnEpoch = func(epoch int, bestCost float64, iterationBestCost float64) {
+		hookCalls++
+	}
+
+	// Act.
+	tour, cost, history := optimizer.SolveWithHistory()
+
+	// Assert.
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Fatalf("Expected a valid tour, got %v.", tour)
+	}
+
+	if len(history) != optimizer.NumberOfEpochs {
+		test.Fatalf("Expected %d history entries, got %d.", optimizer.NumberOfEpochs, len(history))
+	}
+
+	for epoch := 1; epoch < len(history); epoch++ {
+		if history[epoch] > history[epoch-1] {
+			test.Errorf("Best cost increased at epoch %d: %f -> %f.", epoch, history[epoch-1], history[epoch])
+		}
+	}
+
+	if history[len(history)-1] != cost {
+		test.Errorf("Final history value %f differs from returned cost %f.", history[len(history)-1], cost)
+	}
+
+	if hookCalls != optimizer.NumberOfEpochs {
+		test.Errorf("Expected the user hook to be called %d times, got %d.", optimizer.NumberOfEpochs, hookCalls)
+	}
+}
+
+// TestSolveVerbose checks that the last epoch's population has one valid, independently
+// allocated tour per ant, and that the best result matches Solve for the same seed.
+func TestSolveVerbose(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(20, 3))
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 8, 2131)
+	var reference *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 8, 2131)
+
+	optimizer.NumberOfWorkers = 4
+	reference.NumberOfWorkers = 4
+
+	// Act.
+	tour, cost, lastEpochTours := optimizer.SolveVerbose()
+	referenceTour, referenceCost := reference.Solve()
+
+	// Assert.
+	if len(lastEpochTours) != optimizer.NumberOfAnts {
+		test.Fatalf("Expected %d tours from the last epoch, got %d.", optimizer.NumberOfAnts, len(lastEpochTours))
+	}
+
+	var backingArrays map[*int]bool = make(map[*int]bool)
+
+	for index, antTour := range lastEpochTours {
+		valid, antCost := graph.IsValidTour(antTour)
+
+		if !valid {
+			test.Fatalf("Tour %d of the last epoch is not a valid tour: %v.", index, antTour)
+		}
+
+		if antCost < cost-1e-9 {
+			test.Errorf("Tour %d costs %f, below the reported best cost %f.", index, antCost, cost)
+		}
+
+		if backingArrays[&antTour[0]] {
+			test.Errorf("Tour %d shares its backing array with another tour.", index)
+		}
+
+		backingArrays[&antTour[0]] = true
+	}
+
+	if !reflect.DeepEqual(tour, referenceTour) || cost != referenceCost {
+		test.Errorf("SolveVerbose returned %v (%f); want the same best tour as Solve, %v (%f).", tour, cost,
+			referenceTour, referenceCost)
+	}
+}
+
+// TestNoValidTour ensures that on a graph without any Hamiltonian cycle, dead-end tours are not
+// reported as solutions and SolveContext reports ErrNoValidTour.
+func TestNoValidTour(test *testing.T) {
+	// Arrange.
+
+	// Node 0 and node 2 are not connected, so every cycle over all three nodes is impossible.
+	var sparseMatrix [][]float64 = [][]float64{
+		{0, 1, math.Inf(1)},
+		{1, 0, 2},
+		{math.Inf(1), 2, 0},
+	}
+
+	var graph *graph.Graph = graph.NewGraph(sparseMatrix)
+	var optimizer *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 2.0, 0.5, 100.0, 10, 10)
+
+	// Act.
+	tour, cost, err := optimizer.SolveContext(context.Background())
+
+	// Assert.
+	if err != ErrNoValidTour {
+		test.Errorf("Expected ErrNoValidTour, got %v.", err)
+	}
+
+	if len(tour) != 0 {
+		test.Errorf("Expected no tour, got %v.", tour)
+	}
+
+	if !math.IsInf(cost, 1) {
+		test.Errorf("Expected +Inf cost, got %f.", cost)
+	}
+}
+
+// TestCandidateListsProduceValidTours checks that restricting moves to the nearest neighbours
+// still yields complete cycles, including candidate lists too short to finish a tour on their own.
+func TestCandidateListsProduceValidTours(test *testing.T) {
+	for _, size := range []int{1, 3, 10} {
+		// Arrange.
+		var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(60, 2))
+		var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 5, 7)
+
+		optimizer.CandidateListSize = size
+
+		// Act.
+		tour, cost, err := optimizer.SolveContext(context.Background())
+
+		// Assert.
+		if err != nil {
+			test.Fatalf("CandidateListSize %d: unexpected error %v.", size, err)
+		}
+
+		if !isValidTour(tour, graph.NumberOfNodes) {
+			test.Errorf("CandidateListSize %d: expected a valid tour over %d nodes, got %v.", size, graph.NumberOfNodes, tour)
+		}
+
+		if math.Abs(cost-tourCost(tour, graph)) > 1e-9 {
+			test.Errorf("CandidateListSize %d: reported cost %f differs from tour cost %f.", size, cost, tourCost(tour, graph))
+		}
+	}
+}
+
+// TestCandidateListsFollowGraphChanges closes an edge and shortens another between two Solve
+// calls on the same optimizer, and checks that the cached candidate lists are rebuilt.
+func TestCandidateListsFollowGraphChanges(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph([][]float64{
+		{0, 1, 2, 3},
+		{1, 0, 3, 2},
+		{2, 3, 0, 1},
+		{3, 2, 1, 0},
+	})
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 4, 2, 5)
+
+	optimizer.CandidateListSize = 2
+	optimizer.Solve()
+
+	var before []int = optimizer.candidateLists[0]
+
+	// Act.
+	graph.SetDistance(0, 1, math.Inf(1))
+	graph.SetDistance(0, 3, 0.5)
+	optimizer.Solve()
+
+	var after []int = optimizer.candidateLists[0]
+
+	// Assert.
+	if !reflect.DeepEqual(before, []int{1, 2}) {
+		test.Fatalf("Expected node 0's candidates before the change to be [1 2], got %v.", before)
+	}
+
+	if !reflect.DeepEqual(after, []int{3, 2}) {
+		test.Errorf("Expected node 0's candidates after the change to be [3 2], got %v.", after)
+	}
+}
+
+// epochsToReach returns the first epoch whose best cost is at or below target, or the length
+// of the history if the target was never reached.
+func epochsToReach(history []float64, target float64) int {
+	for epoch, cost := range history {
+		if cost <= target {
+			return epoch
+		}
+	}
+
+	return len(history)
+}
+
+// TestGreedySeedingConvergesFaster compares, over several fixed seeds, how many epochs it takes
+// to match the greedy tour's cost with and without greedy pheromone seeding.
+func TestGreedySeedingConvergesFaster(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(30, 5))
+
+	_, greedyCost := graph.GreedyTour(0)
+
+	var uniformEpochs int = 0
+	var seededEpochs int = 0
+
+	// Act.
+	for seed := int64(1); seed <= 5; seed++ {
+		for _, seeded := range []bool{false, true} {
+			var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 2.0, 0.1, 1.0, 10, 100, seed)
+
+			optimizer.SeedWithGreedyTour = seeded
+
+			_, _, history := optimizer.SolveWithHistory()
+
+			if seeded {
+				seededEpochs += epochsToReach(history, greedyCost)
+			} else {
+				uniformEpochs += epochsToReach(history, greedyCost)
+			}
+		}
+	}
+
+	// Assert.
+	if seededEpochs >= uniformEpochs {
+		test.Errorf("Expected seeding to reach cost %f in fewer epochs, got %d seeded vs %d uniform.",
+			greedyCost, seededEpochs, uniformEpochs)
+	}
+}
+
+// TestGreedySeedingOpenTour checks that with ReturnToStart disabled, seeding uses the open
+// greedy path: it succeeds even though the closing edge is infinite, and that edge gets no bonus.
+func TestGreedySeedingOpenTour(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph([][]float64{
+		{0, 1, math.Inf(1)},
+		{1, 0, 2},
+		{math.Inf(1), 2, 0},
+	})
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 3.0, 4, 1, 1)
+
+	optimizer.ReturnToStart = false
+
+	// Act.
+	var seeded bool = optimizer.SeedPheromonesFromGreedyTour()
+
+	// Assert.
+	if !seeded {
+		test.Fatal("Expected seeding from the finite open path 0 -> 1 -> 2.")
+	}
+
+	// The path costs 3, so every edge starts at 1/3 and path edges gain DepositFactor/3 = 1.
+	var values [][]float64 = optimizer.PheromoneLevels.Values
+
+	if math.Abs(values[0][1]-(1.0/3.0+1.0)) > 1e-9 || math.Abs(values[1][2]-(1.0/3.0+1.0)) > 1e-9 {
+		test.Errorf("Expected the path edges at %f, got %f and %f.", 1.0/3.0+1.0, values[0][1], values[1][2])
+	}
+
+	if math.Abs(values[2][0]-1.0/3.0) > 1e-9 {
+		test.Errorf("Expected the closing edge to get no bonus, got %f.", values[2][0])
+	}
+}
+
+// TestOpenTour checks on the 5-city matrix that an open tour visits every node once without the
+// closing edge, and that its cost is exactly the closed tour's cost minus that edge.
+func TestOpenTour(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var pheromones *pheromone.PheromoneMatrix = pheromone.NewPheromoneMatrix(graph.NumberOfNodes, 1.0)
+
+	// The same seed makes both ants take identical moves, so only the closing edge differs.
+	var closedAnt *ant.Ant = ant.NewAnt(graph, pheromones, 1.0, 5.0, rand.New(rand.NewSource(3)))
+	var openAnt *ant.Ant = ant.NewAnt(graph, pheromones, 1.0, 5.0, rand.New(rand.NewSource(3)))
+
+	openAnt.ReturnToStart = false
+
+	// Act.
+	closedAnt.ConstructTour(0)
+	openAnt.ConstructTour(0)
+
+	// Assert.
+	if !openAnt.ValidTour || len(openAnt.PathTaken) != graph.NumberOfNodes {
+		test.Fatalf("Expected a valid open tour of %d nodes, got %v.", graph.NumberOfNodes, openAnt.PathTaken)
+	}
+
+	if len(openAnt.PathTaken) != len(closedAnt.PathTaken)-1 {
+		test.Errorf("Expected the open tour to have one edge fewer: open %v, closed %v.", openAnt.PathTaken, closedAnt.PathTaken)
+	}
+
+	var lastNode int = openAnt.PathTaken[len(openAnt.PathTaken)-1]
+	var closingEdge float64 = graph.DistanceBetween(lastNode, 0)
+
+	if math.Abs(closedAnt.TotalCost-closingEdge-openAnt.TotalCost) > 1e-9 {
+		test.Errorf("Open cost %f; want closed cost %f minus closing edge %f.", openAnt.TotalCost, closedAnt.TotalCost, closingEdge)
+	}
+
+	// The optimizer passes the option on to its ants.
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20, 3)
+
+	optimizer.ReturnToStart = false
+
+	tour, cost := optimizer.Solve()
+
+	if len(tour) != graph.NumberOfNodes || math.Abs(cost-tourCost(tour, graph)) > 1e-9 {
+		test.Errorf("Expected an open tour of %d nodes costing its edges, got %v with cost %f.", graph.NumberOfNodes, tour, cost)
+	}
+}
+
+// validAntsAndBest builds one epoch of tours on the 5-city matrix and returns the ants with
+// valid tours together with the best of them.
+func validAntsAndBest(seed int64) ([]*ant.Ant, *ant.Ant) {
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph.NewGraph(distanceMatrix), 1.0, 2.0, 0.5, 100.0, 10, 1, seed)
+
+	var validAnts []*ant.Ant
+	var best *ant.Ant
+
+	for _, insect := range optimizer.constructTours() {
+		if !insect.ValidTour {
+			continue
+		}
+
+		validAnts = append(validAnts, insect)
+
+		if best == nil || insect.TotalCost < best.TotalCost {
+			best = insect
+		}
+	}
+
+	return validAnts, best
+}
+
+// TestElitistDepositReinforcesBestTour compares the elitist strategy with the plain one on the
+// same ants: global-best edges must gain exactly the bonus, and every other edge must match.
+func TestElitistDepositReinforcesBestTour(test *testing.T) {
+	const ELITIST_WEIGHT float64 = 3.0
+	const DEPOSIT_FACTOR float64 = 100.0
+
+	// Arrange.
+	ants, best := validAntsAndBest(5)
+
+	var plain *pheromone.PheromoneMatrix = pheromone.NewPheromoneMatrix(len(distanceMatrix), 1.0)
+	var elitist *pheromone.PheromoneMatrix = plain.Clone()
+
+	var onBestTour map[[2]int]bool = make(map[[2]int]bool)
+
+	for index := 0; index < len(best.PathTaken)-1; index++ {
+		onBestTour[[2]int{best.PathTaken[index], best.PathTaken[index+1]}] = true
+		onBestTour[[2]int{best.PathTaken[index+1], best.PathTaken[index]}] = true
+	}
+
+	// Act.
+	AllAntsDeposit{}.Deposit(plain, ants, best.PathTaken, best.TotalCost, DEPOSIT_FACTOR)
+	ElitistDeposit{ElitistWeight: ELITIST_WEIGHT}.Deposit(elitist, ants, best.PathTaken, best.TotalCost, DEPOSIT_FACTOR)
+
+	// Assert.
+	var bonus float64 = ELITIST_WEIGHT * DEPOSIT_FACTOR / best.TotalCost
+
+	for row := range distanceMatrix {
+		for column := range distanceMatrix {
+			var difference float64 = elitist.Get(row, column) - plain.Get(row, column)
+
+			if onBestTour[[2]int{row, column}] && math.Abs(difference-bonus) > 1e-9 {
+				test.Errorf("Edge (%d, %d) gained %f over the plain strategy; want %f.", row, column, difference, bonus)
+			}
+
+			if !onBestTour[[2]int{row, column}] && difference != 0 {
+				test.Errorf("Edge (%d, %d) is not on the best tour but gained %f.", row, column, difference)
+			}
+		}
+	}
+}
+
+// TestRankBasedDepositOnlyTopAnts checks that a rank-based strategy of width 1 deposits only
+// along the best ant's tour, with weight 1.
+func TestRankBasedDepositOnlyTopAnts(test *testing.T) {
+	const DEPOSIT_FACTOR float64 = 100.0
+
+	// Arrange.
+	ants, best := validAntsAndBest(9)
+
+	var ranked *pheromone.PheromoneMatrix = pheromone.NewPheromoneMatrix(len(distanceMatrix), 1.0)
+	var expected *pheromone.PheromoneMatrix = ranked.Clone()
+
+	expected.DepositPheromones(best.PathTaken, DEPOSIT_FACTOR/best.TotalCost)
+
+	// Act.
+	RankBasedDeposit{Width: 1}.Deposit(ranked, ants, best.PathTaken, best.TotalCost, DEPOSIT_FACTOR)
+
+	// Assert.
+	if !reflect.DeepEqual(ranked.Values, expected.Values) {
+		test.Errorf("Rank-based deposit = %v; want %v.", ranked.Values, expected.Values)
+	}
+}
+
+// TestSolveWithDepositStrategy ensures that the optimizer runs with each built-in strategy.
+func TestSolveWithDepositStrategy(test *testing.T) {
+	var strategies []DepositStrategy = []DepositStrategy{
+		AllAntsDeposit{},
+		ElitistDeposit{ElitistWeight: 2.0},
+		RankBasedDeposit{Width: 3},
+	}
+
+	for _, strategy := range strategies {
+		// Arrange.
+		var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+		var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 10, 20, 1)
+
+		optimizer.DepositStrategy = strategy
+
+		// Act.
+		tour, cost := optimizer.Solve()
+
+		// Assert.
+		if !isValidTour(tour, graph.NumberOfNodes) || math.IsInf(cost, 1) {
+			test.Errorf("%T: expected a valid tour, got %v with cost %f.", strategy, tour, cost)
+		}
+	}
+}
+
+// multiModalMatrix builds a distance matrix of two tight clusters joined by a few cheap bridges,
+// so that tours which cross between the clusters at the wrong place form local optima.
+func multiModalMatrix() [][]float64 {
+	var matrix [][]float64 = randomCoordinateMatrix(16, 7)
+
+	for row := range matrix {
+		for column := range matrix[row] {
+			if row != column && (row < 8) != (column < 8) {
+				matrix[row][column] += 5
+			}
+		}
+	}
+
+	matrix[0][8], matrix[8][0] = 0.5, 0.5
+	matrix[7][15], matrix[15][7] = 0.5, 0.5
+
+	return matrix
+}
+
+// TestStagnationRestart runs long enough for the best cost to stall and checks that at least one
+// restart occurs, that each restart returns pheromones to their initial level, and that the
+// returned tour is still a valid cycle.
+func TestStagnationRestart(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(multiModalMatrix())
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 2.0, 0.2, 1.0, 8, 200, 3)
+
+	optimizer.NumberOfWorkers = 1
+	optimizer.StagnationLimit = 10
+
+	var restarts int = 0
+
+	optimizer.OnRestart = func(epoch int) {
+		restarts++
+
+		for row := range optimizer.PheromoneLevels.Values {
+			for column, level := range optimizer.PheromoneLevels.Values[row] {
+				if level != 1.0 {
+					test.Fatalf("Expected pheromone 1.0 after restart at epoch %d, got %f on edge (%d, %d).",
+						epoch, level, row, column)
+				}
+			}
+		}
+	}
+
+	// Act.
+	tour, cost := optimizer.Solve()
+
+	// Assert.
+	if restarts == 0 {
+		test.Error("Expected at least one stagnation restart.")
+	}
+
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Errorf("Expected a valid tour, got %v.", tour)
+	}
+
+	if math.Abs(cost-tourCost(tour, graph)) > 1e-9 {
+		test.Errorf("Reported cost %f does not match tour cost %f.", cost, tourCost(tour, graph))
+	}
+}
+
+// TestStagnationRestartBiasedToBest checks that right after a biased restart exactly the edges
+// of one Hamiltonian cycle carry the extra DepositFactor / bestCost and every other edge is back
+// at the initial level.
+func TestStagnationRestartBiasedToBest(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 10.0, 5, 50, 1)
+
+	optimizer.StagnationLimit = 5
+	optimizer.RestartBiasToBest = true
+
+	var bestCostAtRestart float64 = math.Inf(1)
+	var latestBestCost float64 = math.Inf(1)
+	var snapshot *pheromone.PheromoneMatrix
+
+	// OnEpoch runs before the restart check, so it holds the cost the restart was biased toward.
+	optimizer.OnEpoch = func(epoch int, bestCost float64, iterationBestCost float64) {
+		latestBestCost = bestCost
+	}
+
+	optimizer.OnRestart = func(epoch int) {
+		if snapshot == nil {
+			snapshot = optimizer.PheromoneLevels.Clone()
+			bestCostAtRestart = latestBestCost
+		}
+	}
+
+	// Act.
+	optimizer.Solve()
+
+	// Assert.
+	if snapshot == nil {
+		test.Fatal("Expected at least one stagnation restart.")
+	}
+
+	var biasedLevel float64 = 1.0 + optimizer.DepositFactor/bestCostAtRestart
+
+	for row := range snapshot.Values {
+		var biasedEdges int = 0
+
+		for column, level := range snapshot.Values[row] {
+			if math.Abs(level-biasedLevel) < 1e-9 {
+				biasedEdges++
+			} else if level != 1.0 {
+				test.Errorf("Expected edge (%d, %d) at 1.0 or %f after biased restart, got %f.", row, column,
+					biasedLevel, level)
+			}
+		}
+
+		// Each node of a cycle has exactly two incident tour edges.
+		if biasedEdges != 2 {
+			test.Errorf("Expected node %d on 2 biased edges, got %d.", row, biasedEdges)
+		}
+	}
+}
+
+// TestCustomHeuristicChangesSelection compares, for the same seeds, the first move of an ant
+// using the default inverse-distance heuristic with one whose heuristic only allows node 3.
+// The custom heuristic must change the selection and remove all randomness from it.
+func TestCustomHeuristicChangesSelection(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var pheromones *pheromone.PheromoneMatrix = pheromone.NewPheromoneMatrix(graph.NumberOfNodes, 1.0)
+
+	var defaultChoices map[int]int = make(map[int]int)
+	var customChoices map[int]int = make(map[int]int)
+
+	// Act.
+	for seed := int64(0); seed < 100; seed++ {
+		var defaultAnt *ant.Ant = ant.NewAnt(graph, pheromones, 1.0, 5.0, rand.New(rand.NewSource(seed)))
+		var customAnt *ant.Ant = ant.NewAnt(graph, pheromones, 1.0, 5.0, rand.New(rand.NewSource(seed)))
+
+		customAnt.Heuristic = func(from int, to int) float64 {
+			if to == 3 {
+				return 1.0
+			}
+
+			return 0.0
+		}
+
+		defaultChoices[defaultAnt.SelectNextNode(0)]++
+		customChoices[customAnt.SelectNextNode(0)]++
+	}
+
+	// Assert.
+	if customChoices[3] != 100 {
+		test.Errorf("Expected the custom heuristic to always select node 3, got %v.", customChoices)
+	}
+
+	// Node 1 is nearest to node 0, so inverse distance with beta 5 strongly prefers it.
+	if defaultChoices[1] <= defaultChoices[3] {
+		test.Errorf("Expected the default heuristic to prefer node 1 over node 3, got %v.", defaultChoices)
+	}
+}
+
+// TestSolveWithCustomHeuristic passes a heuristic through the optimizer that only permits moving
+// to the next node index, so every ant must walk the nodes in ascending cyclic order.
+func TestSolveWithCustomHeuristic(test *testing.T) {
+	// Arrange.
+	var graph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 2.0, 0.5, 100.0, 5, 5, 4)
+
+	optimizer.Heuristic = func(from int, to int) float64 {
+		if to == (from+1)%graph.NumberOfNodes {
+			return 1.0
+		}
+
+		return 0.0
+	}
+
+	// Act.
+	tour, cost := optimizer.Solve()
+
+	// Assert.
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Fatalf("Expected a valid tour, got %v.", tour)
+	}
+
+	for index := 0; index < len(tour)-1; index++ {
+		if tour[index+1] != (tour[index]+1)%graph.NumberOfNodes {
+			test.Fatalf("Expected ascending cyclic order, got %v.", tour)
+		}
+	}
+
+	// The cost is still the travelled distance, not the heuristic.
+	if math.Abs(cost-tourCost(tour, graph)) > 1e-9 {
+		test.Errorf("Reported cost %f does not match tour cost %f.", cost, tourCost(tour, graph))
+	}
+}
+
+// TestDirectedAsymmetricTour uses a matrix where travelling 0 -> 1 -> 2 -> 3 -> 0 costs 4 but the
+// reverse direction costs 40, and checks that the reported cost follows the travelled directions
+// and that pheromone is only reinforced along them.
+func TestDirectedAsymmetricTour(test *testing.T) {
+	// Arrange.
+	var asymmetricMatrix [][]float64 = [][]float64{
+		{0, 1, 20, 10},
+		{10, 0, 1, 20},
+		{20, 10, 0, 1},
+		{1, 20, 10, 0},
+	}
+
+	var graph *graph.Graph = graph.NewGraph(asymmetricMatrix)
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 2.0, 0.3, 1.0, 8, 30, 6)
+
+	optimizer.Directed = true
+
+	// Act.
+	tour, cost := optimizer.Solve()
+
+	var forwardCost float64 = tourCost(tour, graph)
+	var reverseCost float64 = 0.0
+
+	for index := 0; index < len(tour)-1; index++ {
+		reverseCost += graph.DistanceBetween(tour[index+1], tour[index])
+	}
+
+	// Assert.
+	if !isValidTour(tour, graph.NumberOfNodes) {
+		test.Fatalf("Expected a valid tour, got %v.", tour)
+	}
+
+	if cost != 4 || forwardCost != 4 {
+		test.Errorf("Expected the forward cycle of cost 4, got %v with cost %f.", tour, cost)
+	}
+
+	if reverseCost != 40 {
+		test.Errorf("Expected the reverse of %v to cost 40, got %f.", tour, reverseCost)
+	}
+
+	for node := 0; node < graph.NumberOfNodes; node++ {
+		var next int = (node + 1) % graph.NumberOfNodes
+
+		if optimizer.PheromoneLevels.Get(node, next) <= optimizer.PheromoneLevels.Get(next, node) {
+			test.Errorf("Expected more pheromone on %d -> %d than on %d -> %d, got %f and %f.", node, next, next, node,
+				optimizer.PheromoneLevels.Get(node, next), optimizer.PheromoneLevels.Get(next, node))
+		}
+	}
+}
+
+// TestTournamentSelectionProducesValidTours solves a small and a 50-node graph with seeded
+// tournament selection, checking every constructed tour and that the run is reproducible.
+func TestTournamentSelectionProducesValidTours(test *testing.T) {
+	var matrices [][][]float64 = [][][]float64{distanceMatrix, randomCoordinateMatrix(50, 2109)}
+
+	for _, matrix := range matrices {
+		// Arrange.
+		var problemGraph *graph.Graph = graph.NewGraph(matrix)
+
+		var solve = func() ([]int, float64) {
+			var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(problemGraph, 1.0, 5.0, 0.5, 100.0, 10, 15, 2109)
+
+			optimizer.SelectionMode = ant.TournamentSelection
+			optimizer.TournamentSize = 4
+
+			for _, currentAnt := range optimizer.constructTours() {
+				if valid, cost := problemGraph.IsValidTour(currentAnt.PathTaken); !valid || cost != currentAnt.TotalCost {
+					test.Errorf("Expected a valid tour of cost %f, got %v (valid %v, cost %f).", currentAnt.TotalCost,
+						currentAnt.PathTaken, valid, cost)
+				}
+			}
+
+			return optimizer.Solve()
+		}
+
+		// Act.
+		tour, cost := solve()
+		repeatTour, repeatCost := solve()
+
+		// Assert.
+		if valid, _ := problemGraph.IsValidTour(tour); !valid {
+			test.Errorf("Expected a valid tour over %d nodes, got %v.", problemGraph.NumberOfNodes, tour)
+		}
+
+		if !reflect.DeepEqual(tour, repeatTour) || cost != repeatCost {
+			test.Errorf("Expected identical seeded runs, got %v (%f) and %v (%f).", tour, cost, repeatTour, repeatCost)
+		}
+	}
+}
+
+// TestFullyGreedyQ0 sets q0 to 1 so every move exploits the best edge. With the initial uniform
+// pheromone, each ant must then follow the nearest-neighbour tour from its start node, and
+// seeded runs must be identical.
+func TestFullyGreedyQ0(test *testing.T) {
+	// Arrange.
+	var problemGraph *graph.Graph = graph.NewGraph(distanceMatrix)
+
+	var newOptimizer = func() *AntColonyOptimizer {
+		var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(problemGraph, 1.0, 2.0, 0.5, 100.0, 10, 10, 2110)
+
+		optimizer.Q0 = 1.0
+
+		return optimizer
+	}
+
+	// Act.
+	var ants []*ant.Ant = newOptimizer().constructTours()
+
+	tour, cost := newOptimizer().Solve()
+	repeatTour, repeatCost := newOptimizer().Solve()
+
+	// Assert.
+	for _, currentAnt := range ants {
+		greedyTour, greedyCost := problemGraph.GreedyTour(currentAnt.PathTaken[0])
+
+		if !reflect.DeepEqual(currentAnt.PathTaken, greedyTour) || currentAnt.TotalCost != greedyCost {
+			test.Errorf("Expected the greedy tour %v, got %v.", greedyTour, currentAnt.PathTaken)
+		}
+	}
+
+	if valid, _ := problemGraph.IsValidTour(tour); !valid {
+		test.Errorf("Expected a valid tour, got %v.", tour)
+	}
+
+	if !reflect.DeepEqual(tour, repeatTour) || cost != repeatCost {
+		test.Errorf("Expected identical seeded runs, got %v (%f) and %v (%f).", tour, cost, repeatTour, repeatCost)
+	}
+}
+
+// TestMultiColonyNoWorseThanSingleColony compares, over a set of seeds, single-colony runs with
+// four-colony runs using the same seeds and epoch budget. A single seed may still favour one
+// colony, so the mean best costs are compared. The multi-colony runs must be valid and give
+// identical results whether the colonies run sequentially or in parallel.
+func TestMultiColonyNoWorseThanSingleColony(test *testing.T) {
+	// Arrange.
+	var problemGraph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(30, 2119))
+	var seeds []int64 = []int64{1, 2, 3, 4}
+
+	var singleTotal float64 = 0.0
+	var multiTotal float64 = 0.0
+
+	for _, seed := range seeds {
+		var single *AntColonyOptimizer = NewSeededAntColonyOptimizer(problemGraph, 1.0, 3.0, 0.3, 1.0, 8, 30, seed)
+
+		single.NumberOfWorkers = 1
+
+		_, singleCost := single.Solve()
+
+		singleTotal += singleCost
+
+		var multiCosts []float64 = []float64{}
+
+		for _, workers := range []int{1, 4} {
+			var multi *AntColonyOptimizer = NewSeededAntColonyOptimizer(problemGraph, 1.0, 3.0, 0.3, 1.0, 8, 30, seed)
+
+			multi.NumberOfWorkers = workers
+			multi.ExchangeInterval = 5
+
+			// Act.
+			tour, cost := multi.SolveMultiColony(4)
+
+			// Assert.
+			if valid, tourCost := problemGraph.IsValidTour(tour); !valid || math.Abs(tourCost-cost) > 1e-9 {
+				test.Fatalf("Seed %d: expected a valid tour of cost %f, got %v.", seed, cost, tour)
+			}
+
+			multiCosts = append(multiCosts, cost)
+		}
+
+		if multiCosts[0] != multiCosts[1] {
+			test.Errorf("Seed %d: sequential and parallel colonies differ, %f and %f.", seed, multiCosts[0], multiCosts[1])
+		}
+
+		multiTotal += multiCosts[0]
+	}
+
+	if multiTotal > singleTotal {
+		test.Errorf("Mean multi-colony cost %f is worse than mean single-colony cost %f.",
+			multiTotal/float64(len(seeds)), singleTotal/float64(len(seeds)))
+	}
+}
+
+// noDeposit is a DepositStrategy that leaves the pheromone matrix untouched, so evaporation
+// alone determines how the levels change between epochs.
+type noDeposit struct{}
+
+// Deposit does nothing.
+func (noDeposit) Deposit(pheromones *pheromone.PheromoneMatrix, ants []*ant.Ant, bestTour []int, bestTourCost float64,
+	depositFactor float64) {
+}
+
+// TestEvaporationScheduleAppliedPerEpoch disables deposits and observes, after every epoch,
+// that one edge decayed by exactly the rate the schedule returned for that epoch.
+func TestEvaporationScheduleAppliedPerEpoch(test *testing.T) {
+	// Arrange.
+	var problemGraph *graph.Graph = graph.NewGraph(distanceMatrix)
+	var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(problemGraph, 1.0, 2.0, 0.5, 1.0, 4, 6, 2120)
+
+	var rates []float64 = []float64{0.1, 0.2, 0.3, 0.05, 0.5, 0.0}
+	var requested []int = []int{}
+	var levels []float64 = []float64{optimizer.PheromoneLevels.Get(0, 1)}
+
+	optimizer.DepositStrategy = noDeposit{}
+
+	optimizer.EvaporationSchedule = func(epoch int) float64 {
+		requested = append(requested, epoch)
+
+		return rates[epoch]
+	}
+
+	optimizer.OnEpoch = func(epoch int, bestCost float64, iterationBestCost float64) {
+		levels = append(levels, optimizer.PheromoneLevels.Get(0, 1))
+	}
+
+	// Act.
+	optimizer.Solve()
+
+	// Assert.
+	if !reflect.DeepEqual(requested, []int{0, 1, 2, 3, 4, 5}) {
+		test.Fatalf("Expected the schedule to be asked once per epoch, got %v.", requested)
+	}
+
+	for epoch, rate := range rates {
+		var expected float64 = levels[epoch] * (1.0 - rate)
+
+		if math.Abs(levels[epoch+1]-expected) > 1e-12 {
+			test.Errorf("Epoch %d: level %f; want %f after evaporating at %v.", epoch, levels[epoch+1], expected, rate)
+		}
+	}
+}
+
+// benchmarkSolve runs the optimizer on a 50-node graph with the given number of workers.
+func benchmarkSolve(benchmark *testing.B, workers int) {
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(50, 1))
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		var optimizer *AntColonyOptimizer = NewAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 50, 5)
+
+		optimizer.NumberOfWorkers = workers
+
+		optimizer.Solve()
+	}
+}
+
+// BenchmarkSolveSequential measures tour construction on a single goroutine.
+func BenchmarkSolveSequential(benchmark *testing.B) {
+	benchmarkSolve(benchmark, 1)
+}
+
+// BenchmarkSolveParallel measures tour construction across a worker pool sized to the CPU count.
+func BenchmarkSolveParallel(benchmark *testing.B) {
+	benchmarkSolve(benchmark, runtime.NumCPU())
+}
+
+// benchmarkSolveLarge runs a sequential optimizer on a 200-node graph with the given candidate list size.
+func benchmarkSolveLarge(benchmark *testing.B, candidateListSize int) {
+	var graph *graph.Graph = graph.NewGraph(randomCoordinateMatrix(200, 1))
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		var optimizer *AntColonyOptimizer = NewSeededAntColonyOptimizer(graph, 1.0, 5.0, 0.5, 100.0, 20, 2, 1)
+
+		optimizer.NumberOfWorkers = 1
+		optimizer.CandidateListSize = candidateListSize
+
+		optimizer.Solve()
+	}
+}
+
+// BenchmarkSolveFullScan measures tour construction on 200 nodes when every move scans all nodes.
+func BenchmarkSolveFullScan(benchmark *testing.B) {
+	benchmarkSolveLarge(benchmark, 0)
+}
+
+// BenchmarkSolveCandidateLists measures tour construction on 200 nodes with 15-nearest-neighbour candidate lists.
+func BenchmarkSolveCandidateLists(benchmark *testing.B) {
+	benchmarkSolveLarge(benchmark, 15)
+}
@@ -0,0 +1,86 @@
+// ===================================================================================
+// File:        deposit_strategy.go
+// Package:     antcolonyoptimization
+// Description: This file defines the DepositStrategy interface, which decides how the
+//
+//	ants of an epoch reinforce the pheromone matrix, together with the
+//	built-in strategies.
+//
+//	Key features:
+//	- A pluggable interface so callers can supply their own update rule
+//	- Plain Ant System deposit, where every ant deposits depositFactor / cost
+//	- Elitist deposit, where the global-best tour receives an extra bonus each epoch
+//	- Rank-based deposit, where only the best ants deposit, weighted by their rank
+//
+// Author:      Braiden Gole
+// Created:     July 29, 2025
+//
+// ===================================================================================
+package antcolonyoptimization
+
+import (
+	"sort"
+
+	ant "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Ant"
+	pheromone "github.com/bgolesoftwaredeveloper/ant_colony_optimization/Pheromone"
+)
+
+// DepositStrategy updates the pheromone matrix after evaporation at the end of every epoch.
+//
+// Deposit receives only the ants that built a valid tour, in creation order, along with the
+// best tour found so far (empty with a cost of +Inf while there is none) and the optimizer's
+// DepositFactor. It runs on the optimizer's goroutine and may freely modify pheromones.
+type DepositStrategy interface {
+	Deposit(pheromones *pheromone.PheromoneMatrix, ants []*ant.Ant, bestTour []int, bestTourCost float64, depositFactor float64)
+}
+
+// AllAntsDeposit is the plain Ant System update: every ant deposits depositFactor / cost
+// along its own tour. It matches DepositAllAnts.
+type AllAntsDeposit struct{}
+
+// Deposit has every ant reinforce its own tour in proportion to the tour's quality.
+func (AllAntsDeposit) Deposit(pheromones *pheromone.PheromoneMatrix, ants []*ant.Ant, bestTour []int, bestTourCost float64,
+	depositFactor float64) {
+	for _, insect := range ants {
+		pheromones.DepositPheromones(insect.PathTaken, depositFactor/insect.TotalCost)
+	}
+}
+
+// ElitistDeposit is the Elitist Ant System update: every ant deposits as in AllAntsDeposit,
+// and the global-best tour additionally deposits ElitistWeight * depositFactor / bestCost,
+// as if ElitistWeight extra ants had followed it.
+type ElitistDeposit struct {
+	ElitistWeight float64
+}
+
+// Deposit applies the plain update and then the elitist bonus on the global-best tour.
+func (strategy ElitistDeposit) Deposit(pheromones *pheromone.PheromoneMatrix, ants []*ant.Ant, bestTour []int, bestTourCost float64,
+	depositFactor float64) {
+	AllAntsDeposit{}.Deposit(pheromones, ants, bestTour, bestTourCost, depositFactor)
+
+	if len(bestTour) > 0 {
+		pheromones.DepositPheromones(bestTour, strategy.ElitistWeight*depositFactor/bestTourCost)
+	}
+}
+
+// RankBasedDeposit is the rank-based Ant System update: the ants are ranked by tour cost and
+// only the best Width of them deposit, the ant of rank r (0 for the best) depositing
+// (Width - r) * depositFactor / cost. Ties keep creation order.
+type RankBasedDeposit struct {
+	Width int
+}
+
+// Deposit reinforces the tours of the top Width ants, weighted by their rank.
+func (strategy RankBasedDeposit) Deposit(pheromones *pheromone.PheromoneMatrix, ants []*ant.Ant, bestTour []int, bestTourCost float64,
+	depositFactor float64) {
+	var ranked []*ant.Ant = append([]*ant.Ant(nil), ants...)
+
+	sort.SliceStable(ranked, func(left, right int) bool {
+		return ranked[left].TotalCost < ranked[right].TotalCost
+	})
+
+	for rank := 0; rank < strategy.Width && rank < len(ranked); rank++ {
+		pheromones.DepositPheromones(ranked[rank].PathTaken,
+			float64(strategy.Width-rank)*depositFactor/ranked[rank].TotalCost)
+	}
+}
@@ -10,8 +10,17 @@
 //
 //	Key functionalities include:
 //	- Creating a new Graph from a given distance matrix
+//	- Validating a distance matrix (square, no negative or NaN entries) before use
 //	- Querying the distance between two nodes
+//	- Changing edge distances in one or both directions (e.g. closing a road)
+//	- A version counter so cached data derived from the distances can be refreshed
 //	- Calculating Euclidean distance between two points (utility function)
+//	- Building a full symmetric graph from 2D point coordinates
+//	- Precomputing the k nearest neighbours of every node (candidate lists)
+//	- Building a greedy nearest-neighbour tour as a quick baseline solution
+//	- Building the open greedy nearest-neighbour path for tours that do not return
+//	- Checking that a tour is a valid closed tour and computing its cost
+//	- A 1-tree lower bound on the optimal tour cost for measuring optimality gaps
 //
 // Author:      Braiden Gole
 // Created:     July 29, 2025
@@ -19,6 +28,22 @@
 // ===================================================================================
 package graph
 
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Errors reported by NewGraphChecked for malformed distance matrices, and by SetDistance and
+// SetDirectedDistance for invalid edges.
+var (
+	ErrNonSquareMatrix  = errors.New("graph: distance matrix is not square")
+	ErrNegativeDistance = errors.New("graph: distance matrix contains a negative distance")
+	ErrNaNDistance      = errors.New("graph: distance matrix contains a NaN distance")
+	ErrNodeOutOfRange   = errors.New("graph: node index out of range")
+)
+
 // Graph represents a weighted graph with a distance matrix.
 //
 // NumberOfNodes    - the total count of nodes in the graph
@@ -27,10 +52,15 @@ package graph
 type Graph struct {
 	NumberOfNodes  int
 	DistanceMatrix [][]float64
+	version        uint64
 }
 
 // NewGraph constructs a new Graph instance using the provided distance matrix.
 //
+// The matrix is assumed to be valid and is not checked: a jagged matrix causes an
+// index-out-of-range panic later, during tour construction. Use NewGraphChecked for
+// matrices that come from untrusted input.
+//
 // Parameters:
 //   distanceMatrix - a 2D slice representing distances between nodes;
 //                    must be square (NxN) where N is number of nodes.
@@ -44,6 +74,36 @@ func NewGraph(distanceMatrix [][]float64) *Graph {
 	}
 }
 
+// NewGraphChecked constructs a new Graph like NewGraph, but first validates the distance
+// matrix. Infinite distances are accepted and mark missing edges.
+//
+// Parameters:
+//   distanceMatrix - a 2D slice representing distances between nodes
+//
+// Returns:
+//   Pointer to the newly created Graph, or nil and an error wrapping ErrNonSquareMatrix,
+//   ErrNegativeDistance or ErrNaNDistance that names the offending row or entry.
+func NewGraphChecked(distanceMatrix [][]float64) (*Graph, error) {
+	for row := range distanceMatrix {
+		if len(distanceMatrix[row]) != len(distanceMatrix) {
+			return nil, fmt.Errorf("%w: row %d has %d entries, want %d", ErrNonSquareMatrix, row,
+				len(distanceMatrix[row]), len(distanceMatrix))
+		}
+
+		for column, distance := range distanceMatrix[row] {
+			if math.IsNaN(distance) {
+				return nil, fmt.Errorf("%w: entry [%d][%d]", ErrNaNDistance, row, column)
+			}
+
+			if distance < 0 {
+				return nil, fmt.Errorf("%w: entry [%d][%d] is %v", ErrNegativeDistance, row, column, distance)
+			}
+		}
+	}
+
+	return NewGraph(distanceMatrix), nil
+}
+
 // DistanceBetween returns the distance between the source and destination nodes.
 //
 // Parameters:
@@ -55,3 +115,383 @@ func NewGraph(distanceMatrix [][]float64) *Graph {
 func (graph *Graph) DistanceBetween(source int, destination int) float64 {
 	return graph.DistanceMatrix[source][destination]
 }
+
+// SetDistance changes the distance between nodes i and j in both directions, keeping a
+// symmetric graph symmetric. Use an infinite distance to remove the edge. The distance matrix
+// is updated in place, so a matrix passed to NewGraph by the caller changes as well.
+//
+// Parameters:
+//   i, j     - the indices of the endpoints of the edge
+//   distance - the new distance; must not be negative or NaN
+//
+// Returns:
+//   nil, or an error wrapping ErrNodeOutOfRange, ErrNegativeDistance or ErrNaNDistance, in
+//   which case the graph is unchanged.
+func (graph *Graph) SetDistance(i int, j int, distance float64) error {
+	if err := graph.SetDirectedDistance(i, j, distance); err != nil {
+		return err
+	}
+
+	graph.DistanceMatrix[j][i] = distance
+
+	return nil
+}
+
+// SetDirectedDistance changes the distance from node i to node j only, for asymmetric
+// problems such as one-way roads. Use an infinite distance to remove the edge. The distance
+// matrix is updated in place, as with SetDistance.
+//
+// Parameters:
+//   i        - the index of the source node
+//   j        - the index of the destination node
+//   distance - the new distance; must not be negative or NaN
+//
+// Returns:
+//   nil, or an error wrapping ErrNodeOutOfRange, ErrNegativeDistance or ErrNaNDistance, in
+//   which case the graph is unchanged.
+func (graph *Graph) SetDirectedDistance(i int, j int, distance float64) error {
+	if i < 0 || i >= graph.NumberOfNodes || j < 0 || j >= graph.NumberOfNodes {
+		return fmt.Errorf("%w: edge (%d, %d) in a graph of %d nodes", ErrNodeOutOfRange, i, j, graph.NumberOfNodes)
+	}
+
+	if math.IsNaN(distance) {
+		return fmt.Errorf("%w: edge (%d, %d)", ErrNaNDistance, i, j)
+	}
+
+	if distance < 0 {
+		return fmt.Errorf("%w: edge (%d, %d) is %v", ErrNegativeDistance, i, j, distance)
+	}
+
+	graph.DistanceMatrix[i][j] = distance
+	graph.version++
+
+	return nil
+}
+
+// Version returns a counter that SetDistance and SetDirectedDistance increase on every change,
+// so callers caching data derived from the distances (such as candidate lists) can tell when
+// it is stale. Writes made directly to DistanceMatrix are not counted.
+//
+// Returns:
+//   The number of edge changes made through the setters since the graph was created.
+func (graph *Graph) Version() uint64 {
+	return graph.version
+}
+
+// EuclideanDistance returns the straight-line distance between the points
+// (xAxis, yAxis) and (otherXAxis, otherYAxis).
+//
+// Parameters:
+//   xAxis, yAxis           - coordinates of the first point
+//   otherXAxis, otherYAxis - coordinates of the second point
+//
+// Returns:
+//   The Euclidean distance as a float64 value.
+func EuclideanDistance(xAxis float64, yAxis float64, otherXAxis float64, otherYAxis float64) float64 {
+	var deltaX float64 = otherXAxis - xAxis
+	var deltaY float64 = otherYAxis - yAxis
+
+	return math.Sqrt(deltaX*deltaX + deltaY*deltaY)
+}
+
+// NewGraphFromCoordinates constructs a complete graph whose nodes are the given 2D points,
+// with the distance between every pair of nodes set to their Euclidean distance.
+//
+// Parameters:
+//   points - slice of (x, y) coordinates; node i corresponds to points[i]
+//
+// Returns:
+//   Pointer to the newly created Graph with a symmetric, zero-diagonal distance matrix.
+func NewGraphFromCoordinates(points [][2]float64) *Graph {
+	var distanceMatrix [][]float64 = make([][]float64, len(points))
+
+	for row := range distanceMatrix {
+		distanceMatrix[row] = make([]float64, len(points))
+	}
+
+	// Fill the upper triangle and mirror it to keep the matrix exactly symmetric.
+	for row := range points {
+		for column := row + 1; column < len(points); column++ {
+			var distance float64 = EuclideanDistance(points[row][0], points[row][1], points[column][0], points[column][1])
+
+			distanceMatrix[row][column] = distance
+			distanceMatrix[column][row] = distance
+		}
+	}
+
+	return NewGraph(distanceMatrix)
+}
+
+// NearestNeighbors returns, for every node, the indices of its k closest other nodes ordered
+// from nearest to farthest. Unreachable neighbours (infinite or NaN distance) are never listed,
+// so a node may have fewer than k candidates; ties are broken by the lower node index.
+//
+// Parameters:
+//   k - the maximum number of neighbours kept per node; values of 0 or less yield empty lists
+//
+// Returns:
+//   A slice where entry i holds the candidate list of node i.
+func (graph *Graph) NearestNeighbors(k int) [][]int {
+	var candidates [][]int = make([][]int, graph.NumberOfNodes)
+
+	if k < 0 {
+		k = 0
+	}
+
+	for node := 0; node < graph.NumberOfNodes; node++ {
+		var neighbours []int = make([]int, 0, graph.NumberOfNodes-1)
+
+		for other := 0; other < graph.NumberOfNodes; other++ {
+			var distance float64 = graph.DistanceBetween(node, other)
+
+			if other == node || math.IsInf(distance, 0) || math.IsNaN(distance) {
+				continue
+			}
+
+			neighbours = append(neighbours, other)
+		}
+
+		sort.SliceStable(neighbours, func(left, right int) bool {
+			return graph.DistanceBetween(node, neighbours[left]) < graph.DistanceBetween(node, neighbours[right])
+		})
+
+		if len(neighbours) > k {
+			neighbours = neighbours[:k]
+		}
+
+		candidates[node] = neighbours
+	}
+
+	return candidates
+}
+
+// GreedyPath builds an open path with the nearest-neighbour heuristic: starting from start, it
+// always moves to the closest unvisited node until every node is visited, without returning.
+//
+// If some node cannot be reached (every remaining edge is infinite or NaN), the partial path is
+// returned together with a cost of +Inf.
+//
+// Parameters:
+//   start - the index of the node the path begins at
+//
+// Returns:
+//   path - slice of node indices visiting every node once
+//   cost - total distance of the path
+func (graph *Graph) GreedyPath(start int) ([]int, float64) {
+	var path []int = make([]int, 0, graph.NumberOfNodes+1)
+	var visited []bool = make([]bool, graph.NumberOfNodes)
+
+	var cost float64 = 0.0
+	var current int = start
+
+	path = append(path, start)
+	visited[start] = true
+
+	for len(path) < graph.NumberOfNodes {
+		var next int = -1
+		var nearest float64 = math.Inf(1)
+
+		for candidate := 0; candidate < graph.NumberOfNodes; candidate++ {
+			if visited[candidate] {
+				continue
+			}
+
+			if distance := graph.DistanceBetween(current, candidate); distance < nearest {
+				nearest = distance
+				next = candidate
+			}
+		}
+
+		// Dead end: every unvisited node is unreachable from here.
+		if next == -1 {
+			return path, math.Inf(1)
+		}
+
+		path = append(path, next)
+		visited[next] = true
+		cost += nearest
+		current = next
+	}
+
+	return path, cost
+}
+
+// GreedyTour builds a tour with the nearest-neighbour heuristic: it follows GreedyPath from
+// start and finally returns to start.
+//
+// If some node cannot be reached (every remaining edge is infinite or NaN), or the closing
+// edge is unusable, the partial tour is returned together with a cost of +Inf.
+//
+// Parameters:
+//   start - the index of the node the tour begins and ends at
+//
+// Returns:
+//   tour - slice of node indices visiting every node once and ending back at start
+//   cost - total distance of the tour
+func (graph *Graph) GreedyTour(start int) ([]int, float64) {
+	tour, cost := graph.GreedyPath(start)
+
+	if math.IsInf(cost, 1) {
+		return tour, cost
+	}
+
+	cost += graph.DistanceBetween(tour[len(tour)-1], start)
+	tour = append(tour, start)
+
+	if math.IsNaN(cost) {
+		cost = math.Inf(1)
+	}
+
+	return tour, cost
+}
+
+// IsValidTour reports whether tour is a closed tour over the whole graph: it must visit every
+// node exactly once, end back at its first node, and only use finite edges.
+//
+// Parameters:
+//   tour - slice of node indices, including the closing return to the start node
+//
+// Returns:
+//   valid - true if the tour satisfies every condition above
+//   cost  - total distance of the tour when valid, or +Inf otherwise
+func (graph *Graph) IsValidTour(tour []int) (bool, float64) {
+	if graph.NumberOfNodes == 0 || len(tour) != graph.NumberOfNodes+1 || tour[0] != tour[len(tour)-1] {
+		return false, math.Inf(1)
+	}
+
+	var visited []bool = make([]bool, graph.NumberOfNodes)
+
+	for _, node := range tour[:len(tour)-1] {
+		if node < 0 || node >= graph.NumberOfNodes || visited[node] {
+			return false, math.Inf(1)
+		}
+
+		visited[node] = true
+	}
+
+	var cost float64 = 0.0
+
+	for index := 0; index < len(tour)-1; index++ {
+		var distance float64 = graph.DistanceBetween(tour[index], tour[index+1])
+
+		if math.IsInf(distance, 0) || math.IsNaN(distance) {
+			return false, math.Inf(1)
+		}
+
+		cost += distance
+	}
+
+	return true, cost
+}
+
+// edgeWeight returns the cost of travelling between nodes i and j in the cheaper direction,
+// treating a NaN distance as a missing (infinite) edge.
+func (graph *Graph) edgeWeight(i int, j int) float64 {
+	var weight float64 = math.Min(graph.DistanceBetween(i, j), graph.DistanceBetween(j, i))
+
+	if math.IsNaN(weight) {
+		return math.Inf(1)
+	}
+
+	return weight
+}
+
+// OneTreeLowerBound returns a lower bound on the cost of the optimal closed tour, for reporting
+// the optimality gap of a heuristic solution such as an ACO result.
+//
+// For every choice of a special node s, a 1-tree is built: a minimum spanning tree over the
+// other nodes (Prim's algorithm) plus the two cheapest edges joining s to them. Every closed
+// tour is a 1-tree, so the cost of each minimum 1-tree bounds the optimum from below, and the
+// largest of them is returned. Each edge is weighted by its cheaper direction, so the bound
+// also holds for asymmetric matrices. The computation takes O(n^3) time.
+//
+// Returns:
+//   The lower bound: 0 for graphs with fewer than two nodes, and +Inf when missing (infinite
+//   or NaN) edges leave the graph disconnected, in which case no tour exists.
+func (graph *Graph) OneTreeLowerBound() float64 {
+	var nodeCount int = graph.NumberOfNodes
+
+	if nodeCount < 2 {
+		return 0
+	}
+
+	// With two nodes the only tour uses the single edge in both directions.
+	if nodeCount == 2 {
+		return 2 * graph.edgeWeight(0, 1)
+	}
+
+	var bound float64 = 0.0
+
+	for special := 0; special < nodeCount; special++ {
+		var cost float64 = graph.spanningTreeCostWithout(special)
+
+		// The two cheapest edges joining the special node to the tree.
+		var cheapest float64 = math.Inf(1)
+		var secondCheapest float64 = math.Inf(1)
+
+		for other := 0; other < nodeCount; other++ {
+			if other == special {
+				continue
+			}
+
+			var weight float64 = graph.edgeWeight(special, other)
+
+			if weight < cheapest {
+				cheapest, secondCheapest = weight, cheapest
+			} else if weight < secondCheapest {
+				secondCheapest = weight
+			}
+		}
+
+		bound = math.Max(bound, cost+cheapest+secondCheapest)
+	}
+
+	return bound
+}
+
+// spanningTreeCostWithout returns the cost of a minimum spanning tree over every node except
+// the excluded one, using Prim's algorithm, or +Inf if those nodes are not connected.
+func (graph *Graph) spanningTreeCostWithout(excluded int) float64 {
+	var inTree []bool = make([]bool, graph.NumberOfNodes)
+	var distanceToTree []float64 = make([]float64, graph.NumberOfNodes)
+
+	var cost float64 = 0.0
+	var start int = 0
+
+	if excluded == 0 {
+		start = 1
+	}
+
+	for node := range distanceToTree {
+		distanceToTree[node] = math.Inf(1)
+	}
+
+	inTree[excluded] = true
+	distanceToTree[start] = 0
+
+	for added := 0; added < graph.NumberOfNodes-1; added++ {
+		var next int = -1
+
+		// Pick the closest node not yet in the tree.
+		for node := 0; node < graph.NumberOfNodes; node++ {
+			if !inTree[node] && (next == -1 || distanceToTree[node] < distanceToTree[next]) {
+				next = node
+			}
+		}
+
+		if math.IsInf(distanceToTree[next], 1) {
+			return math.Inf(1)
+		}
+
+		inTree[next] = true
+		cost += distanceToTree[next]
+
+		for node := 0; node < graph.NumberOfNodes; node++ {
+			if !inTree[node] {
+				distanceToTree[node] = math.Min(distanceToTree[node], graph.edgeWeight(next, node))
+			}
+		}
+	}
+
+	return cost
+}
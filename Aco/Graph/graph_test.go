@@ -0,0 +1,580 @@
+// ===================================================================================
+// File:        graph_test.go
+// Package:     graph
+// Description: This file contains unit tests for the Graph type used by the Ant Colony
+//
+//	Optimization (ACO) algorithm.
+//
+//	The tests in this file cover:
+//
+//	- Validation of jagged, negative and NaN distance matrices
+//	- Euclidean distance between two points
+//	- Building a complete graph from 2D coordinates
+//	- Nearest-neighbour candidate lists
+//	- Greedy nearest-neighbour tours and open paths
+//	- Tour validation (closed tours, missing nodes and infinite edges)
+//	- Changing edge distances symmetrically and directionally, with invalid edges rejected
+//	  and the graph version increased on every change
+//	- The 1-tree lower bound against brute-force optimal tours
+//
+//	All tests are written using Go’s built-in "testing" package.
+//
+// Author:      Braiden Gole
+// Created:     July 29, 2025
+//
+// Test Coverage:
+//
+//	✅ TestNewGraphCheckedAcceptsValidMatrix
+//	✅ TestNewGraphCheckedRejectsJaggedMatrix
+//	✅ TestNewGraphCheckedRejectsNegativeDistance
+//	✅ TestNewGraphCheckedRejectsNaNDistance
+//	✅ TestEuclideanDistance
+//	✅ TestNewGraphFromCoordinates
+//	✅ TestNearestNeighbors
+//	✅ TestGreedyTour
+//	✅ TestGreedyTourDeadEnd
+//	✅ TestGreedyPath
+//	✅ TestIsValidTourClosedTour
+//	✅ TestIsValidTourMissingNode
+//	✅ TestIsValidTourInfiniteEdge
+//	✅ TestSetDistance
+//	✅ TestSetDirectedDistance
+//	✅ TestSetDistanceRejectsInvalidEdges
+//	✅ TestOneTreeLowerBound
+//	✅ TestOneTreeLowerBoundEdgeCases
+//
+// Usage:
+//
+//	To run all tests:
+//	$ go test
+//
+// ===================================================================================
+package graph
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// TestNewGraphCheckedAcceptsValidMatrix ensures a square, non-negative matrix with a missing
+// (infinite) edge is accepted.
+func TestNewGraphCheckedAcceptsValidMatrix(test *testing.T) {
+	// Act.
+	graph, err := NewGraphChecked([][]float64{
+		{0, 1, math.Inf(1)},
+		{1, 0, 2},
+		{math.Inf(1), 2, 0},
+	})
+
+	// Assert.
+	if err != nil {
+		test.Fatalf("Unexpected error %v.", err)
+	}
+
+	if graph.NumberOfNodes != 3 {
+		test.Errorf("Expected 3 nodes, got %d.", graph.NumberOfNodes)
+	}
+}
+
+// TestNewGraphCheckedRejectsJaggedMatrix ensures rows of the wrong length are reported.
+func TestNewGraphCheckedRejectsJaggedMatrix(test *testing.T) {
+	// Act.
+	graph, err := NewGraphChecked([][]float64{
+		{0, 1, 2},
+		{1, 0},
+		{2, 3, 0},
+	})
+
+	// Assert.
+	if !errors.Is(err, ErrNonSquareMatrix) {
+		test.Errorf("Expected ErrNonSquareMatrix, got %v.", err)
+	}
+
+	if graph != nil {
+		test.Errorf("Expected no graph, got %v.", graph)
+	}
+}
+
+// TestNewGraphCheckedRejectsNegativeDistance ensures negative weights are reported.
+func TestNewGraphCheckedRejectsNegativeDistance(test *testing.T) {
+	// Act.
+	graph, err := NewGraphChecked([][]float64{
+		{0, -1},
+		{-1, 0},
+	})
+
+	// Assert.
+	if !errors.Is(err, ErrNegativeDistance) {
+		test.Errorf("Expected ErrNegativeDistance, got %v.", err)
+	}
+
+	if graph != nil {
+		test.Errorf("Expected no graph, got %v.", graph)
+	}
+}
+
+// TestNewGraphCheckedRejectsNaNDistance ensures NaN entries are reported.
+func TestNewGraphCheckedRejectsNaNDistance(test *testing.T) {
+	// Act.
+	_, err := NewGraphChecked([][]float64{
+		{0, math.NaN()},
+		{1, 0},
+	})
+
+	// Assert.
+	if !errors.Is(err, ErrNaNDistance) {
+		test.Errorf("Expected ErrNaNDistance, got %v.", err)
+	}
+}
+
+// TestEuclideanDistance checks the distance function on a 3-4-5 right triangle in both directions.
+func TestEuclideanDistance(test *testing.T) {
+	// Act.
+	var forward float64 = EuclideanDistance(0, 0, 3, 4)
+	var backward float64 = EuclideanDistance(3, 4, 0, 0)
+
+	// Assert.
+	if forward != 5.0 || backward != 5.0 {
+		test.Errorf("Expected distance 5 in both directions, got %f and %f.", forward, backward)
+	}
+
+	if distance := EuclideanDistance(1, 2, 1, 2); distance != 0.0 {
+		test.Errorf("Expected distance 0 between identical points, got %f.", distance)
+	}
+}
+
+// TestNewGraphFromCoordinates builds a graph from three points and compares it to the expected matrix.
+func TestNewGraphFromCoordinates(test *testing.T) {
+	// Arrange.
+	var points [][2]float64 = [][2]float64{
+		{0, 0},
+		{3, 0},
+		{3, 4},
+	}
+
+	var expected [][]float64 = [][]float64{
+		{0, 3, 5},
+		{3, 0, 4},
+		{5, 4, 0},
+	}
+
+	// Act.
+	var graph *Graph = NewGraphFromCoordinates(points)
+
+	// Assert.
+	if graph.NumberOfNodes != len(points) {
+		test.Fatalf("Expected %d nodes, got %d.", len(points), graph.NumberOfNodes)
+	}
+
+	for row := range expected {
+		for column := range expected[row] {
+			if math.Abs(graph.DistanceBetween(row, column)-expected[row][column]) > 1e-12 {
+				test.Errorf("Distance[%d][%d] = %f; want %f.", row, column, graph.DistanceBetween(row, column),
+					expected[row][column])
+			}
+		}
+	}
+}
+
+// TestNearestNeighbors checks that candidate lists are sorted by distance, truncated to k,
+// and skip unreachable nodes.
+func TestNearestNeighbors(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 4, 1, math.Inf(1)},
+		{4, 0, 2, 3},
+		{1, 2, 0, 5},
+		{math.Inf(1), 3, 5, 0},
+	})
+
+	var expected [][]int = [][]int{
+		{2, 1},
+		{2, 3},
+		{0, 1},
+		{1, 2},
+	}
+
+	// Act.
+	var candidates [][]int = graph.NearestNeighbors(2)
+
+	// Assert.
+	if !reflect.DeepEqual(candidates, expected) {
+		test.Errorf("NearestNeighbors(2) = %v; want %v.", candidates, expected)
+	}
+
+	if all := graph.NearestNeighbors(10); len(all[0]) != 2 || len(all[1]) != 3 {
+		test.Errorf("Expected unreachable nodes to be skipped, got %v.", all)
+	}
+}
+
+// TestGreedyTour checks that the greedy tour visits every node exactly once, returns to the
+// start, follows the nearest neighbour at every step, and reports the matching cost.
+func TestGreedyTour(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 2, 9, 10, 7},
+		{2, 0, 6, 4, 3},
+		{9, 6, 0, 8, 5},
+		{10, 4, 8, 0, 1},
+		{7, 3, 5, 1, 0},
+	})
+
+	// Act.
+	tour, cost := graph.GreedyTour(0)
+
+	// Assert.
+	var expected []int = []int{0, 1, 4, 3, 2, 0}
+
+	if !reflect.DeepEqual(tour, expected) {
+		test.Errorf("GreedyTour(0) = %v; want %v.", tour, expected)
+	}
+
+	var visited map[int]bool = make(map[int]bool)
+
+	for _, node := range tour[:len(tour)-1] {
+		if visited[node] {
+			test.Errorf("Node %d visited more than once in %v.", node, tour)
+		}
+
+		visited[node] = true
+	}
+
+	if len(visited) != graph.NumberOfNodes || tour[0] != tour[len(tour)-1] {
+		test.Errorf("Expected a closed tour over %d nodes, got %v.", graph.NumberOfNodes, tour)
+	}
+
+	if cost != 23 {
+		test.Errorf("GreedyTour(0) cost = %f; want 23.", cost)
+	}
+}
+
+// TestGreedyTourDeadEnd ensures that a greedy walk into an unreachable node reports an infinite cost.
+func TestGreedyTourDeadEnd(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 1, math.Inf(1)},
+		{1, 0, 2},
+		{math.Inf(1), 2, 0},
+	})
+
+	// Act.
+	_, cost := graph.GreedyTour(0)
+
+	// Assert.
+	if !math.IsInf(cost, 1) {
+		test.Errorf("Expected +Inf cost, got %f.", cost)
+	}
+}
+
+// TestGreedyPath checks that the open greedy path visits every node and stays finite when only
+// the edge closing it into a tour is missing.
+func TestGreedyPath(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 1, math.Inf(1)},
+		{1, 0, 2},
+		{math.Inf(1), 2, 0},
+	})
+
+	// Act.
+	path, cost := graph.GreedyPath(0)
+
+	// Assert.
+	if !reflect.DeepEqual(path, []int{0, 1, 2}) || cost != 3 {
+		test.Errorf("GreedyPath(0) = %v, %f; want [0 1 2], 3.", path, cost)
+	}
+}
+
+// TestIsValidTourClosedTour ensures a closed tour over every node is valid and reports its cost.
+func TestIsValidTourClosedTour(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 2, 9, 10},
+		{2, 0, 6, 4},
+		{9, 6, 0, 8},
+		{10, 4, 8, 0},
+	})
+
+	// Act.
+	valid, cost := graph.IsValidTour([]int{0, 1, 3, 2, 0})
+
+	// Assert.
+	if !valid {
+		test.Fatal("Expected the closed tour to be valid.")
+	}
+
+	if cost != 23 {
+		test.Errorf("IsValidTour cost = %f; want 23.", cost)
+	}
+}
+
+// TestIsValidTourMissingNode ensures tours that skip or repeat a node, or are not closed, are rejected.
+func TestIsValidTourMissingNode(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 2, 9, 10},
+		{2, 0, 6, 4},
+		{9, 6, 0, 8},
+		{10, 4, 8, 0},
+	})
+
+	var tours [][]int = [][]int{
+		{0, 1, 3, 0},
+		{0, 1, 3, 1, 0},
+		{0, 1, 3, 2},
+		{0, 1, 3, 4, 0},
+	}
+
+	for _, tour := range tours {
+		// Act.
+		valid, cost := graph.IsValidTour(tour)
+
+		// Assert.
+		if valid || !math.IsInf(cost, 1) {
+			test.Errorf("IsValidTour(%v) = %v, %f; want false, +Inf.", tour, valid, cost)
+		}
+	}
+}
+
+// TestIsValidTourInfiniteEdge ensures a tour that crosses a missing (infinite) edge is rejected.
+func TestIsValidTourInfiniteEdge(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 1, math.Inf(1)},
+		{1, 0, 2},
+		{math.Inf(1), 2, 0},
+	})
+
+	// Act.
+	valid, cost := graph.IsValidTour([]int{0, 1, 2, 0})
+
+	// Assert.
+	if valid || !math.IsInf(cost, 1) {
+		test.Errorf("IsValidTour = %v, %f; want false, +Inf.", valid, cost)
+	}
+}
+
+// TestSetDistance changes an edge and closes another, checking both directions are updated.
+func TestSetDistance(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 1, 4},
+		{1, 0, 2},
+		{4, 2, 0},
+	})
+
+	// Act.
+	var changeErr error = graph.SetDistance(0, 2, 3)
+	var closeErr error = graph.SetDistance(1, 2, math.Inf(1))
+
+	// Assert.
+	if changeErr != nil || closeErr != nil {
+		test.Fatalf("Unexpected errors %v and %v.", changeErr, closeErr)
+	}
+
+	if graph.DistanceBetween(0, 2) != 3 || graph.DistanceBetween(2, 0) != 3 {
+		test.Errorf("DistanceBetween(0, 2) = %f, (2, 0) = %f; want 3 both.", graph.DistanceBetween(0, 2),
+			graph.DistanceBetween(2, 0))
+	}
+
+	if !math.IsInf(graph.DistanceBetween(1, 2), 1) || !math.IsInf(graph.DistanceBetween(2, 1), 1) {
+		test.Errorf("Expected the closed edge to be +Inf both ways, got %f and %f.", graph.DistanceBetween(1, 2),
+			graph.DistanceBetween(2, 1))
+	}
+
+	if valid, _ := graph.IsValidTour([]int{0, 1, 2, 0}); valid {
+		test.Error("Expected a tour over the closed edge to be invalid.")
+	}
+	if graph.Version() == 0 {
+		test.Error("Expected the graph version to increase after changing edges.")
+	}
+}
+
+// TestSetDirectedDistance changes one direction of an edge and checks the other is untouched.
+func TestSetDirectedDistance(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 1},
+		{1, 0},
+	})
+
+	// Act.
+	var err error = graph.SetDirectedDistance(0, 1, 5)
+
+	// Assert.
+	if err != nil {
+		test.Fatalf("Unexpected error %v.", err)
+	}
+
+	if graph.DistanceBetween(0, 1) != 5 || graph.DistanceBetween(1, 0) != 1 {
+		test.Errorf("DistanceBetween(0, 1) = %f, (1, 0) = %f; want 5 and 1.", graph.DistanceBetween(0, 1),
+			graph.DistanceBetween(1, 0))
+	}
+}
+
+// TestSetDistanceRejectsInvalidEdges ensures out-of-range nodes and invalid distances are
+// rejected with the matching error and leave the graph unchanged.
+func TestSetDistanceRejectsInvalidEdges(test *testing.T) {
+	// Arrange.
+	var graph *Graph = NewGraph([][]float64{
+		{0, 1},
+		{1, 0},
+	})
+
+	var tests = []struct {
+		i        int
+		j        int
+		distance float64
+		expected error
+	}{
+		{i: 0, j: 2, distance: 1, expected: ErrNodeOutOfRange},
+		{i: -1, j: 0, distance: 1, expected: ErrNodeOutOfRange},
+		{i: 0, j: 1, distance: -1, expected: ErrNegativeDistance},
+		{i: 0, j: 1, distance: math.NaN(), expected: ErrNaNDistance},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var err error = graph.SetDistance(specificTest.i, specificTest.j, specificTest.distance)
+		var directedErr error = graph.SetDirectedDistance(specificTest.i, specificTest.j, specificTest.distance)
+
+		// Assert.
+		if !errors.Is(err, specificTest.expected) || !errors.Is(directedErr, specificTest.expected) {
+			test.Errorf("SetDistance(%d, %d, %v) = %v and %v; want %v.", specificTest.i, specificTest.j,
+				specificTest.distance, err, directedErr, specificTest.expected)
+		}
+	}
+
+	if graph.DistanceBetween(0, 1) != 1 || graph.DistanceBetween(1, 0) != 1 {
+		test.Errorf("Expected the graph to be unchanged, got %v.", graph.DistanceMatrix)
+	}
+}
+
+// optimalTourCost returns the cost of the cheapest closed tour starting at node 0, found by
+// trying every permutation of the other nodes.
+func optimalTourCost(graph *Graph) float64 {
+	var best float64 = math.Inf(1)
+	var tour []int = []int{0}
+	var used []bool = make([]bool, graph.NumberOfNodes)
+
+	var extend func(cost float64)
+
+	extend = func(cost float64) {
+		var last int = tour[len(tour)-1]
+
+		if len(tour) == graph.NumberOfNodes {
+			best = math.Min(best, cost+graph.DistanceBetween(last, 0))
+
+			return
+		}
+
+		for next := 1; next < graph.NumberOfNodes; next++ {
+			if used[next] {
+				continue
+			}
+
+			used[next] = true
+			tour = append(tour, next)
+			extend(cost + graph.DistanceBetween(last, next))
+			tour = tour[:len(tour)-1]
+			used[next] = false
+		}
+	}
+
+	used[0] = true
+	extend(0)
+
+	return best
+}
+
+// TestOneTreeLowerBound checks that the bound never exceeds the optimal tour cost, found by
+// brute force, and that it is tight on a unit square.
+func TestOneTreeLowerBound(test *testing.T) {
+	var tests = []struct {
+		name  string
+		graph *Graph
+		tight bool
+	}{
+		{
+			name: "Five cities",
+			graph: NewGraph([][]float64{
+				{0, 2, 9, 10, 7},
+				{2, 0, 6, 4, 3},
+				{9, 6, 0, 8, 5},
+				{10, 4, 8, 0, 6},
+				{7, 3, 5, 6, 0},
+			}),
+		},
+		{
+			name:  "Unit square",
+			graph: NewGraphFromCoordinates([][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}}),
+			tight: true,
+		},
+		{
+			name:  "Random points",
+			graph: NewGraphFromCoordinates([][2]float64{{0.1, 0.7}, {0.9, 0.2}, {0.4, 0.4}, {0.8, 0.9}, {0.3, 0.1}, {0.6, 0.6}, {0.2, 0.95}, {0.75, 0.45}}),
+		},
+		{
+			name: "Asymmetric",
+			graph: NewGraph([][]float64{
+				{0, 1, 8, 4},
+				{6, 0, 2, 9},
+				{3, 7, 0, 1},
+				{1, 5, 6, 0},
+			}),
+		},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var bound float64 = specificTest.graph.OneTreeLowerBound()
+		var optimum float64 = optimalTourCost(specificTest.graph)
+
+		// Assert.
+		if bound <= 0 || bound > optimum+1e-9 {
+			test.Errorf("%s: OneTreeLowerBound = %f; want a positive bound no greater than the optimum %f.",
+				specificTest.name, bound, optimum)
+		}
+
+		if specificTest.tight && math.Abs(bound-optimum) > 1e-9 {
+			test.Errorf("%s: OneTreeLowerBound = %f; want exactly the optimum %f.", specificTest.name, bound, optimum)
+		}
+	}
+}
+
+// TestOneTreeLowerBoundEdgeCases checks tiny graphs and a graph split by missing edges.
+func TestOneTreeLowerBoundEdgeCases(test *testing.T) {
+	var infinity float64 = math.Inf(1)
+
+	var tests = []struct {
+		name     string
+		graph    *Graph
+		expected float64
+	}{
+		{name: "Empty", graph: NewGraph([][]float64{}), expected: 0},
+		{name: "Single node", graph: NewGraph([][]float64{{0}}), expected: 0},
+		{name: "Two nodes", graph: NewGraph([][]float64{{0, 3}, {3, 0}}), expected: 6},
+		{
+			name: "Disconnected",
+			graph: NewGraph([][]float64{
+				{0, 1, infinity, infinity},
+				{1, 0, infinity, infinity},
+				{infinity, infinity, 0, 1},
+				{infinity, infinity, 1, 0},
+			}),
+			expected: infinity,
+		},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var bound float64 = specificTest.graph.OneTreeLowerBound()
+
+		// Assert.
+		if bound != specificTest.expected {
+			test.Errorf("%s: OneTreeLowerBound = %f; want %f.", specificTest.name, bound, specificTest.expected)
+		}
+	}
+}
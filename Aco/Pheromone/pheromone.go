@@ -11,6 +11,11 @@
 //	- Initialization with a given size and initial pheromone value
 //	- Evaporation of pheromone levels by a specified rate to simulate decay over time
 //	- Depositing pheromones along a given path, increasing pheromone levels on edges
+//	- Clamping pheromone levels into [min, max] bounds (Max-Min Ant System)
+//	- Symmetric reads and writes of single edges, and deep copies for checkpointing
+//	- CSV export for heatmaps and lookup of the most-reinforced edge
+//	- Optional directed mode for asymmetric problems, updating only travelled directions
+//	- A map-backed sparse variant for large, sparse graphs (sparse_pheromone.go)
 //
 //	This structure is essential for controlling the probabilistic path selection of ants
 //	in the ACO metaheuristic by dynamically adjusting edge desirability.
@@ -21,11 +26,19 @@
 // ===================================================================================
 package pheromone
 
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
 // PheromoneMatrix represents a 2D matrix of pheromone levels for edges between nodes
 // in a graph, used in Ant Colony Optimization (ACO) algorithms.
 //
 // Each entry Values[i][j] holds the pheromone intensity on the edge from node i to node j.
-// The matrix is symmetric as pheromones are deposited bidirectionally.
+// The matrix is symmetric as pheromones are deposited bidirectionally, unless Directed is
+// set, in which case deposits and writes only touch the direction actually travelled, as
+// needed for asymmetric problems where the cost of i -> j differs from j -> i.
 //
 // This structure supports initialization with a uniform pheromone value, evaporation to
 // simulate pheromone decay, and pheromone deposition along ant traversal paths to guide
@@ -34,7 +47,8 @@ package pheromone
 // By dynamically updating pheromone levels, the PheromoneMatrix helps balance exploration
 // and exploitation in finding optimized paths on the problem graph.
 type PheromoneMatrix struct {
-	Values [][]float64
+	Values   [][]float64
+	Directed bool
 }
 
 // NewPheromoneMatrix creates and initializes a new PheromoneMatrix with the specified
@@ -74,7 +88,8 @@ func (matrix *PheromoneMatrix) Evaporate(evaporationRate float64) {
 }
 
 // DepositPheromones adds pheromone amounts along the edges defined by the given path.
-// Both directions of each edge are incremented to maintain symmetry.
+// Both directions of each edge are incremented to maintain symmetry, unless the matrix is
+// Directed, in which case only the direction travelled by the path is incremented.
 //
 // Parameters:
 //   path          - slice of node indices representing the path taken by an ant
@@ -88,6 +103,124 @@ func (matrix *PheromoneMatrix) DepositPheromones(path []int, depositAmount float
 		to = path[index+1]
 
 		matrix.Values[from][to] += depositAmount
-		matrix.Values[to][from] += depositAmount
+
+		if !matrix.Directed {
+			matrix.Values[to][from] += depositAmount
+		}
 	}
 }
+
+// Clamp limits every pheromone level to the inclusive range [min, max], as done by the
+// Max-Min Ant System to keep any edge from becoming overwhelmingly (or never) attractive.
+//
+// Parameters:
+//   min - the lowest pheromone level allowed on any edge
+//   max - the highest pheromone level allowed on any edge
+func (matrix *PheromoneMatrix) Clamp(min float64, max float64) {
+	for row := range matrix.Values {
+		for column := range matrix.Values[row] {
+			if matrix.Values[row][column] < min {
+				matrix.Values[row][column] = min
+			} else if matrix.Values[row][column] > max {
+				matrix.Values[row][column] = max
+			}
+		}
+	}
+}
+
+// Get returns the pheromone level on the edge from node i to node j.
+//
+// Parameters:
+//   i - the index of the source node
+//   j - the index of the destination node
+//
+// Returns:
+//   The pheromone level as a float64 value.
+func (matrix *PheromoneMatrix) Get(i int, j int) float64 {
+	return matrix.Values[i][j]
+}
+
+// Set assigns the pheromone level of the edge between nodes i and j in both directions,
+// so the matrix stays symmetric. A Directed matrix only updates the edge from i to j.
+//
+// Parameters:
+//   i     - the index of one endpoint of the edge
+//   j     - the index of the other endpoint of the edge
+//   value - the new pheromone level
+func (matrix *PheromoneMatrix) Set(i int, j int, value float64) {
+	matrix.Values[i][j] = value
+
+	if !matrix.Directed {
+		matrix.Values[j][i] = value
+	}
+}
+
+// Clone returns a deep copy of the matrix that shares no storage with the original, for
+// checkpointing pheromone state or warm-starting another run.
+//
+// Returns:
+//   Pointer to the newly created PheromoneMatrix.
+func (matrix *PheromoneMatrix) Clone() *PheromoneMatrix {
+	var values [][]float64 = make([][]float64, len(matrix.Values))
+
+	for row := range matrix.Values {
+		values[row] = append([]float64(nil), matrix.Values[row]...)
+	}
+
+	return &PheromoneMatrix{Values: values, Directed: matrix.Directed}
+}
+
+// WriteCSV writes the matrix as comma-separated rows, one row per node, so the learned
+// pheromone distribution can be plotted as a heatmap. Values use the shortest decimal
+// representation that parses back to the same float64.
+//
+// Parameters:
+//   w - the destination of the CSV output
+//
+// Returns:
+//   The first error encountered while writing, or nil.
+func (matrix *PheromoneMatrix) WriteCSV(w io.Writer) error {
+	var writer *csv.Writer = csv.NewWriter(w)
+	var record []string
+
+	for row := range matrix.Values {
+		record = make([]string, len(matrix.Values[row]))
+
+		for column, value := range matrix.Values[row] {
+			record[column] = strconv.FormatFloat(value, 'g', -1, 64)
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// MaxEdge returns the edge carrying the most pheromone, i.e. the one the colony reinforced
+// the most. Self-loops on the diagonal are ignored, and ties go to the first edge in row-major
+// order, so a symmetric matrix reports the edge with i < j.
+//
+// Returns:
+//   i, j  - the endpoints of the strongest edge, or -1, -1 if the matrix has fewer than two nodes
+//   value - the pheromone level on that edge (0 if there is no edge)
+func (matrix *PheromoneMatrix) MaxEdge() (i int, j int, value float64) {
+	i, j = -1, -1
+
+	for row := range matrix.Values {
+		for column, level := range matrix.Values[row] {
+			if row == column {
+				continue
+			}
+
+			if i == -1 || level > value {
+				i, j, value = row, column, level
+			}
+		}
+	}
+
+	return i, j, value
+}
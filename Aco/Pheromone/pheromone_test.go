@@ -0,0 +1,311 @@
+// ===================================================================================
+// File:        pheromone_test.go
+// Package:     pheromone
+// Description: This file contains unit tests for the PheromoneMatrix type used by the
+//
+//	Ant Colony Optimization (ACO) algorithm.
+//
+//	The tests in this file cover:
+//
+//	- Symmetric updates of a single edge through Set and Get
+//	- Deep copies that are independent of the original matrix
+//	- CSV export round-trips and the most-reinforced edge
+//	- One-directional deposits and writes on directed matrices
+//	- Sparse matrices matching the dense matrix and using far less memory
+//
+//	All tests are written using Go’s built-in "testing" package.
+//
+// Author:      Braiden Gole
+// Created:     July 29, 2025
+//
+// Test Coverage:
+//
+//	✅ TestSetKeepsMatrixSymmetric
+//	✅ TestCloneIsIndependent
+//	✅ TestWriteCSVRoundTrip
+//	✅ TestMaxEdge
+//	✅ TestDirectedDepositOnlyTravelledDirection
+//	✅ TestSparseMatchesDense
+//	✅ TestSparseEvaporatesOnlyStoredEdges
+//	✅ TestSparseUsesLessMemory
+//
+// Usage:
+//
+//	To run all tests:
+//	$ go test
+//
+// ===================================================================================
+package pheromone
+
+import (
+	"bytes"
+	"encoding/csv"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// isSymmetric reports whether Values[i][j] equals Values[j][i] for every pair of nodes.
+func isSymmetric(matrix *PheromoneMatrix) bool {
+	for row := range matrix.Values {
+		for column := range matrix.Values[row] {
+			if matrix.Values[row][column] != matrix.Values[column][row] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// TestSetKeepsMatrixSymmetric sets several edges and checks both directions are updated.
+func TestSetKeepsMatrixSymmetric(test *testing.T) {
+	// Arrange.
+	var matrix *PheromoneMatrix = NewPheromoneMatrix(4, 1.0)
+
+	// Act.
+	matrix.Set(0, 2, 3.5)
+	matrix.Set(3, 1, 0.25)
+
+	// Assert.
+	if matrix.Get(0, 2) != 3.5 || matrix.Get(2, 0) != 3.5 {
+		test.Errorf("Get(0, 2) = %f, Get(2, 0) = %f; want 3.5 both.", matrix.Get(0, 2), matrix.Get(2, 0))
+	}
+
+	if matrix.Get(1, 3) != 0.25 || matrix.Get(3, 1) != 0.25 {
+		test.Errorf("Get(1, 3) = %f, Get(3, 1) = %f; want 0.25 both.", matrix.Get(1, 3), matrix.Get(3, 1))
+	}
+
+	if !isSymmetric(matrix) {
+		test.Errorf("Expected a symmetric matrix, got %v.", matrix.Values)
+	}
+}
+
+// TestCloneIsIndependent ensures that changes to a clone never reach the original, and vice versa.
+func TestCloneIsIndependent(test *testing.T) {
+	// Arrange.
+	var original *PheromoneMatrix = NewPheromoneMatrix(3, 1.0)
+
+	original.Set(0, 1, 2.0)
+
+	// Act.
+	var clone *PheromoneMatrix = original.Clone()
+
+	clone.Set(0, 1, 9.0)
+	clone.Evaporate(0.5)
+	original.Set(1, 2, 4.0)
+
+	// Assert.
+	if original.Get(0, 1) != 2.0 || original.Get(0, 0) != 1.0 {
+		test.Errorf("Original changed through its clone: %v.", original.Values)
+	}
+
+	if clone.Get(0, 1) != 4.5 || clone.Get(1, 2) != 0.5 {
+		test.Errorf("Clone changed through its original: %v.", clone.Values)
+	}
+}
+
+// TestWriteCSVRoundTrip writes a small matrix, parses the CSV back, and checks every value survives exactly.
+func TestWriteCSVRoundTrip(test *testing.T) {
+	// Arrange.
+	var matrix *PheromoneMatrix = NewPheromoneMatrix(3, 1.0)
+
+	matrix.Set(0, 1, 0.1)
+	matrix.Set(1, 2, 123456.789)
+	matrix.Set(0, 2, 1.0/3.0)
+
+	var buffer bytes.Buffer
+
+	// Act.
+	var err error = matrix.WriteCSV(&buffer)
+
+	records, parseErr := csv.NewReader(&buffer).ReadAll()
+
+	// Assert.
+	if err != nil || parseErr != nil {
+		test.Fatalf("Expected no errors, got write %v and parse %v.", err, parseErr)
+	}
+
+	if len(records) != 3 {
+		test.Fatalf("Expected 3 rows, got %d.", len(records))
+	}
+
+	for row, record := range records {
+		if len(record) != 3 {
+			test.Fatalf("Expected 3 columns in row %d, got %d.", row, len(record))
+		}
+
+		for column, field := range record {
+			value, convertErr := strconv.ParseFloat(field, 64)
+
+			if convertErr != nil || value != matrix.Get(row, column) {
+				test.Errorf("Cell (%d, %d) = %q; want %v.", row, column, field, matrix.Get(row, column))
+			}
+		}
+	}
+}
+
+// TestMaxEdge checks that the strongest off-diagonal edge is found and that the diagonal is ignored.
+func TestMaxEdge(test *testing.T) {
+	// Arrange.
+	var matrix *PheromoneMatrix = NewPheromoneMatrix(4, 1.0)
+
+	matrix.Values[2][2] = 100.0
+	matrix.Set(1, 3, 7.5)
+	matrix.Set(0, 2, 4.0)
+
+	// Act.
+	i, j, value := matrix.MaxEdge()
+	emptyI, emptyJ, emptyValue := NewPheromoneMatrix(1, 5.0).MaxEdge()
+
+	// Assert.
+	if i != 1 || j != 3 || value != 7.5 {
+		test.Errorf("MaxEdge() = (%d, %d, %f); want (1, 3, 7.5).", i, j, value)
+	}
+
+	if emptyI != -1 || emptyJ != -1 || emptyValue != 0 {
+		test.Errorf("MaxEdge() on one node = (%d, %d, %f); want (-1, -1, 0).", emptyI, emptyJ, emptyValue)
+	}
+}
+
+// TestDirectedDepositOnlyTravelledDirection deposits along a path on a directed matrix and checks
+// that only the travelled directions change, and that a clone stays directed.
+func TestDirectedDepositOnlyTravelledDirection(test *testing.T) {
+	// Arrange.
+	var matrix *PheromoneMatrix = NewPheromoneMatrix(3, 1.0)
+
+	matrix.Directed = true
+
+	// Act.
+	matrix.DepositPheromones([]int{0, 1, 2, 0}, 0.5)
+
+	var clone *PheromoneMatrix = matrix.Clone()
+
+	clone.Set(1, 0, 4.0)
+
+	// Assert.
+	for _, edge := range [][2]int{{0, 1}, {1, 2}, {2, 0}} {
+		if matrix.Get(edge[0], edge[1]) != 1.5 {
+			test.Errorf("Get(%d, %d) = %f; want 1.5.", edge[0], edge[1], matrix.Get(edge[0], edge[1]))
+		}
+
+		if matrix.Get(edge[1], edge[0]) != 1.0 {
+			test.Errorf("Get(%d, %d) = %f; want 1.0 for the untravelled direction.", edge[1], edge[0],
+				matrix.Get(edge[1], edge[0]))
+		}
+	}
+
+	if clone.Get(1, 0) != 4.0 || clone.Get(0, 1) != 1.5 {
+		test.Errorf("Expected the clone to stay directed, got %v.", clone.Values)
+	}
+}
+
+// TestSparseMatchesDense applies the same sequence of deposits, writes, and evaporations to a
+// dense and a sparse matrix, both undirected and directed, and compares every edge. A default
+// of 0 makes evaporation of the unset edges a no-op on the dense matrix too.
+func TestSparseMatchesDense(test *testing.T) {
+	for _, directed := range []bool{false, true} {
+		// Arrange.
+		var dense *PheromoneMatrix = NewPheromoneMatrix(5, 0.0)
+		var sparse *SparsePheromoneMatrix = NewSparsePheromoneMatrix(0.0)
+
+		dense.Directed = directed
+		sparse.Directed = directed
+
+		// Act.
+		for _, matrix := range []interface {
+			Evaporate(evaporationRate float64)
+			DepositPheromones(path []int, depositAmount float64)
+			Set(i int, j int, value float64)
+		}{dense, sparse} {
+			matrix.DepositPheromones([]int{0, 1, 2, 3, 4, 0}, 2.0)
+			matrix.Evaporate(0.25)
+			matrix.Set(3, 1, 0.75)
+			matrix.DepositPheromones([]int{0, 2, 4}, 1.0)
+			matrix.Evaporate(0.5)
+		}
+
+		// Assert.
+		for row := 0; row < 5; row++ {
+			for column := 0; column < 5; column++ {
+				if dense.Get(row, column) != sparse.Get(row, column) {
+					test.Errorf("Directed %v: Get(%d, %d) = %f sparse, %f dense.", directed, row, column,
+						sparse.Get(row, column), dense.Get(row, column))
+				}
+			}
+		}
+	}
+}
+
+// TestSparseEvaporatesOnlyStoredEdges checks that unset edges report the default value before
+// and after evaporation, while deposited edges decay from the default.
+func TestSparseEvaporatesOnlyStoredEdges(test *testing.T) {
+	// Arrange.
+	var matrix *SparsePheromoneMatrix = NewSparsePheromoneMatrix(1.0)
+
+	// Act.
+	matrix.DepositPheromones([]int{0, 1}, 3.0)
+	matrix.Evaporate(0.5)
+
+	// Assert.
+	if matrix.Get(0, 1) != 2.0 || matrix.Get(1, 0) != 2.0 {
+		test.Errorf("Get(0, 1) = %f, Get(1, 0) = %f; want 2.0 both.", matrix.Get(0, 1), matrix.Get(1, 0))
+	}
+
+	if matrix.Get(5, 7) != 1.0 {
+		test.Errorf("Get(5, 7) = %f; want the default 1.0.", matrix.Get(5, 7))
+	}
+
+	if len(matrix.Values) != 2 {
+		test.Errorf("Expected 2 stored edges, got %d.", len(matrix.Values))
+	}
+}
+
+// allocatedBytes returns the number of heap bytes allocated while running build.
+func allocatedBytes(build func()) uint64 {
+	var before runtime.MemStats
+	var after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+	build()
+	runtime.ReadMemStats(&after)
+
+	return after.TotalAlloc - before.TotalAlloc
+}
+
+// TestSparseUsesLessMemory deposits a single tour over a 2000-node graph, where a dense matrix
+// needs 2000 × 2000 entries but the sparse one only stores the 4000 directed tour edges.
+func TestSparseUsesLessMemory(test *testing.T) {
+	const NODE_COUNT int = 2000
+
+	// Arrange.
+	var tour []int = make([]int, NODE_COUNT+1)
+
+	for index := range tour {
+		tour[index] = index % NODE_COUNT
+	}
+
+	var dense *PheromoneMatrix
+	var sparse *SparsePheromoneMatrix
+
+	// Act.
+	var denseBytes uint64 = allocatedBytes(func() {
+		dense = NewPheromoneMatrix(NODE_COUNT, 0.0)
+		dense.DepositPheromones(tour, 1.0)
+	})
+
+	var sparseBytes uint64 = allocatedBytes(func() {
+		sparse = NewSparsePheromoneMatrix(0.0)
+		sparse.DepositPheromones(tour, 1.0)
+	})
+
+	// Assert.
+	if sparseBytes*10 > denseBytes {
+		test.Errorf("Expected the sparse matrix to use under a tenth of the dense memory, got %d and %d bytes.",
+			sparseBytes, denseBytes)
+	}
+
+	if dense.Get(0, 1) != sparse.Get(0, 1) || len(sparse.Values) != 2*NODE_COUNT {
+		test.Errorf("Expected matching tours with %d stored edges, got %d.", 2*NODE_COUNT, len(sparse.Values))
+	}
+}
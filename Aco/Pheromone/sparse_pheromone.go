@@ -0,0 +1,114 @@
+// ===================================================================================
+// File:        sparse_pheromone.go
+// Package:     pheromone
+// Description: This file implements the SparsePheromoneMatrix type, a map-backed
+//
+//	alternative to PheromoneMatrix for large, sparse graphs where most
+//	distances are infinite and a dense n×n matrix would be mostly wasted.
+//
+//	Features implemented in this file:
+//	- Storage of only the edges that were deposited on or set explicitly
+//	- A configurable default pheromone level reported for every unset edge
+//	- Evaporation that only touches the stored edges
+//	- The same Evaporate, DepositPheromones, Get, and Set methods as the dense
+//	  matrix, including its optional directed mode
+//
+// Author:      Braiden Gole
+// Created:     July 29, 2025
+//
+// ===================================================================================
+package pheromone
+
+// SparsePheromoneMatrix stores pheromone levels for the edges of a graph in a map keyed by
+// the (from, to) node pair, so memory grows with the number of edges used rather than with
+// the square of the node count.
+//
+// Edges that were never deposited on or set report DefaultValue and are not stored, so
+// evaporation leaves them at DefaultValue. As with PheromoneMatrix, updates are applied to
+// both directions of an edge unless Directed is set.
+type SparsePheromoneMatrix struct {
+	Values       map[[2]int]float64
+	DefaultValue float64
+	Directed     bool
+}
+
+// NewSparsePheromoneMatrix creates an empty SparsePheromoneMatrix whose unset edges report
+// the given default pheromone level.
+//
+// Parameters:
+//   defaultValue - the pheromone level of every edge that has not been stored
+//
+// Returns:
+//   Pointer to the newly created SparsePheromoneMatrix.
+func NewSparsePheromoneMatrix(defaultValue float64) *SparsePheromoneMatrix {
+	return &SparsePheromoneMatrix{
+		Values:       make(map[[2]int]float64),
+		DefaultValue: defaultValue,
+	}
+}
+
+// Evaporate reduces the pheromone levels on all stored edges by the given evaporation rate.
+// Unset edges keep reporting DefaultValue.
+//
+// Parameters:
+//   evaporationRate - the fraction of pheromone to evaporate (e.g., 0.1 reduces pheromone by 10%)
+func (matrix *SparsePheromoneMatrix) Evaporate(evaporationRate float64) {
+	for edge := range matrix.Values {
+		matrix.Values[edge] *= (1.0 - evaporationRate)
+	}
+}
+
+// DepositPheromones adds pheromone amounts along the edges defined by the given path. An
+// edge that is not stored yet starts from DefaultValue. Both directions of each edge are
+// incremented, unless the matrix is Directed.
+//
+// Parameters:
+//   path          - slice of node indices representing the path taken by an ant
+//   depositAmount - the amount of pheromone to deposit on each edge along the path
+func (matrix *SparsePheromoneMatrix) DepositPheromones(path []int, depositAmount float64) {
+	var from int
+	var to int
+
+	for index := 0; index < len(path)-1; index++ {
+		from = path[index]
+		to = path[index+1]
+
+		matrix.Values[[2]int{from, to}] = matrix.Get(from, to) + depositAmount
+
+		if !matrix.Directed {
+			matrix.Values[[2]int{to, from}] = matrix.Get(to, from) + depositAmount
+		}
+	}
+}
+
+// Get returns the pheromone level on the edge from node i to node j, or DefaultValue if the
+// edge is not stored.
+//
+// Parameters:
+//   i - the index of the source node
+//   j - the index of the destination node
+//
+// Returns:
+//   The pheromone level as a float64 value.
+func (matrix *SparsePheromoneMatrix) Get(i int, j int) float64 {
+	if value, exists := matrix.Values[[2]int{i, j}]; exists {
+		return value
+	}
+
+	return matrix.DefaultValue
+}
+
+// Set assigns the pheromone level of the edge between nodes i and j in both directions,
+// or only from i to j if the matrix is Directed.
+//
+// Parameters:
+//   i     - the index of one endpoint of the edge
+//   j     - the index of the other endpoint of the edge
+//   value - the new pheromone level
+func (matrix *SparsePheromoneMatrix) Set(i int, j int, value float64) {
+	matrix.Values[[2]int{i, j}] = value
+
+	if !matrix.Directed {
+		matrix.Values[[2]int{j, i}] = value
+	}
+}
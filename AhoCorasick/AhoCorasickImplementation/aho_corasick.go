@@ -8,9 +8,24 @@
 //	simultaneously in linear time.
 //
 //	Features implemented in this package:
-//	- Trie-based pattern insertion
-//	- Failure link construction (like KMP fallback logic)
+//	- Trie-based pattern insertion (empty patterns are rejected)
+//	- Failure link construction (like KMP fallback logic), built on demand when stale
+//	  or explicitly through Rebuild after patterns are added or removed
 //	- Efficient multi-pattern search with overlap support
+//	- Streaming search over an io.Reader with state carried across reads
+//	- Pattern removal with trie pruning and automatic failure link rebuild
+//	- Non-overlapping leftmost-longest search for tokenization
+//	- Structured, position-ordered match results with end offsets
+//	- Longest-match-only results per ending position
+//	- Minimum pattern length filtering of search results
+//	- Concurrent search over overlapping segments of very large texts
+//	- Callback-driven replacement of matched spans (e.g. redaction)
+//	- Highlighting of matched spans with open/close delimiters (e.g. <b>...</b>)
+//	- Automaton size statistics for memory tuning
+//	- Graphviz DOT dump of the trie and its failure links for debugging
+//	- A byte-indexed automaton variant for ASCII-heavy workloads (byte_aho_corasick.go)
+//	- Single-character wildcard patterns (e.g. "h?llo"), matched by branching on
+//	  the literal and wildcard children of every active trie node
 //
 //	The algorithm is useful in applications such as virus scanning,
 //	natural language processing, lexical analysis, and intrusion detection.
@@ -21,61 +36,231 @@
 // ===================================================================================
 package ahocorasickimplementation
 
-import "container/list"
+import (
+	"bufio"
+	"container/list"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrEmptyPattern is returned by AddPattern when asked to register an empty pattern.
+// An empty pattern would conceptually match at every position of every text, so it is
+// rejected rather than stored on the root node.
+var ErrEmptyPattern = errors.New("aho-corasick: empty pattern")
 
 // Node represents a single state in the Aho-Corasick trie.
 // Each node maintains links to child nodes, a failure link, and a list of patterns matched at that node.
+// The patterns slice holds only the patterns terminating at this node, while output also includes
+// the patterns inherited through the failure link. The wildcard child is followed on any character
+// and only exists below patterns added with AddPatternWithWildcard.
 type Node struct {
 	children map[rune]*Node
+	wildcard *Node
 	fail     *Node
+	patterns []string
 	output   []string
 }
 
+// Match represents a single occurrence of a pattern in the searched text.
+// Start and End are byte offsets with End exclusive, so text[Start:End] equals Pattern
+// (for wildcard patterns, the wildcard positions hold whatever characters matched).
+type Match struct {
+	Pattern string
+	Start   int
+	End     int
+}
+
+// AutomatonStats summarizes the size of the automaton's trie.
+//
+// NodeCount        - the number of trie nodes, including the root
+// PatternCount     - the number of distinct patterns registered
+// MaxPatternLength - the length in bytes of the longest registered pattern
+// MaxDepth         - the depth of the deepest trie node, counted in runes from the root
+type AutomatonStats struct {
+	NodeCount        int
+	PatternCount     int
+	MaxPatternLength int
+	MaxDepth         int
+}
+
 // AhoCorasick represents the main automaton structure containing the root node.
+// The built flag records whether the failure links reflect the current trie. The wildcard
+// rune is interpreted by AddPatternWithWildcard, and wildcardPatterns counts the patterns
+// registered that way; while it is non-zero, searches branch through the trie instead of
+// following failure links.
 type AhoCorasick struct {
-	root *Node
+	root             *Node
+	built            bool
+	wildcard         rune
+	wildcardPatterns int
+}
+
+// thread tracks one partial match during a wildcard-aware search: the trie node reached so
+// far and the byte offset in the text where the match began.
+type thread struct {
+	node  *Node
+	start int
 }
 
 // NewAhoCorasick initializes and returns a new instance of the Aho-Corasick automaton.
+// The wildcard rune used by AddPatternWithWildcard defaults to '?'.
 func NewAhoCorasick() *AhoCorasick {
 	return &AhoCorasick{
 		root: &Node{
 			children: make(map[rune]*Node),
 			output:   []string{},
 		},
+		wildcard: '?',
 	}
 }
 
+// newNode creates an empty trie node.
+func newNode() *Node {
+	return &Node{
+		children: make(map[rune]*Node),
+		output:   []string{},
+	}
+}
+
+// SetWildcard changes the rune that AddPatternWithWildcard treats as matching any single
+// character. Patterns that were already added keep their meaning.
+func (aho *AhoCorasick) SetWildcard(wildcard rune) {
+	aho.wildcard = wildcard
+}
+
 // AddPattern inserts a pattern into the trie, character by character.
 // Each new character creates a new node if it doesn't already exist.
-func (aho *AhoCorasick) AddPattern(pattern string) {
+// Adding a pattern marks the failure links as stale so the next search rebuilds them.
+// Returns ErrEmptyPattern, leaving the trie unchanged, if the pattern is empty.
+func (aho *AhoCorasick) AddPattern(pattern string) error {
+	if pattern == "" {
+		return ErrEmptyPattern
+	}
+
 	var node *Node = aho.root
 
 	// Traverse (or build) down the trie based on each rune in the pattern.
 	for _, character := range pattern {
 		if _, exists := node.children[character]; !exists {
 			// Create a new node if the path doesn't exist.
-			node.children[character] = &Node{
-				children: make(map[rune]*Node),
-				output:   []string{},
-			}
+			node.children[character] = newNode()
 		}
 
 		node = node.children[character]
 	}
 
 	// Register the complete pattern at the terminal node.
-	node.output = append(node.output, pattern)
+	node.patterns = append(node.patterns, pattern)
+
+	aho.built = false
+
+	return nil
+}
+
+// AddPatternWithWildcard inserts a pattern in which every occurrence of the wildcard rune
+// (see SetWildcard) matches any single character of the text, so "h?llo" matches "hello" and
+// "hallo" but not "hllo". Wildcard positions are stored on a dedicated child of each trie node,
+// so they never collide with a literal pattern containing the same rune. Once a wildcard pattern
+// exists, searches branch on both the literal and the wildcard child at every step, which costs
+// time proportional to the number of partial matches alive at each character rather than the
+// strict linear time of the failure-link automaton. Patterns added this way cannot be removed
+// with RemovePattern. Returns ErrEmptyPattern, leaving the trie unchanged, if the pattern is empty.
+func (aho *AhoCorasick) AddPatternWithWildcard(pattern string) error {
+	if pattern == "" {
+		return ErrEmptyPattern
+	}
+
+	var node *Node = aho.root
+
+	for _, character := range pattern {
+		if character == aho.wildcard {
+			if node.wildcard == nil {
+				node.wildcard = newNode()
+			}
+
+			node = node.wildcard
+
+			continue
+		}
+
+		if _, exists := node.children[character]; !exists {
+			node.children[character] = newNode()
+		}
+
+		node = node.children[character]
+	}
+
+	node.patterns = append(node.patterns, pattern)
+
+	aho.wildcardPatterns++
+	aho.built = false
+
+	return nil
+}
+
+// RemovePattern removes a literal pattern from the trie, unregistering its output and pruning
+// any nodes that no longer lead to a pattern. The failure links are marked as stale so
+// the next search rebuilds them automatically. Returns true if the pattern existed.
+func (aho *AhoCorasick) RemovePattern(pattern string) bool {
+	var path []*Node = []*Node{aho.root}
+	var characters []rune = []rune(pattern)
+
+	var node *Node = aho.root
+
+	// Walk down the trie, recording the path taken.
+	for _, character := range characters {
+		next, exists := node.children[character]
+
+		if !exists {
+			return false
+		}
+
+		node = next
+		path = append(path, node)
+	}
+
+	if len(node.patterns) == 0 {
+		return false
+	}
+
+	// Unregister the pattern from its terminal node.
+	node.patterns = nil
+
+	// Prune nodes bottom-up that have neither children (literal or wildcard) nor patterns of their own.
+	for index := len(characters); index > 0; index-- {
+		var current *Node = path[index]
+
+		if len(current.children) > 0 || current.wildcard != nil || len(current.patterns) > 0 {
+			break
+		}
+
+		delete(path[index-1].children, characters[index-1])
+	}
+
+	aho.built = false
+
+	return true
 }
 
 // BuildFailureLinks constructs the failure links (fallbacks) used during pattern search.
-// This step is essential to enable fast traversal when mismatches occur.
+// This step is essential to enable fast traversal when mismatches occur. Searches call it
+// automatically whenever patterns were added or removed since the last build, so calling it
+// explicitly is optional.
 func (aho *AhoCorasick) BuildFailureLinks() {
 	var queue *list.List = list.New()
 
+	// Reset the root output so a rebuild never accumulates stale patterns.
+	aho.root.output = append([]string{}, aho.root.patterns...)
+
 	// Set fail links of depth-1 children to root and enqueue them for BFS.
 	for _, child := range aho.root.children {
 		child.fail = aho.root
+		child.output = append([]string{}, child.patterns...)
 		queue.PushBack(child)
 	}
 
@@ -91,6 +276,9 @@ func (aho *AhoCorasick) BuildFailureLinks() {
 		for character, child := range current.children {
 			fail = current.fail
 
+			// Start from the node's own patterns before merging inherited output.
+			child.output = append([]string{}, child.patterns...)
+
 			// Start following failure links from the parent’s fail node.
 			for fail != nil && fail.children[character] == nil {
 				fail = fail.fail
@@ -111,32 +299,524 @@ func (aho *AhoCorasick) BuildFailureLinks() {
 			queue.PushBack(child)
 		}
 	}
+
+	aho.built = true
+}
+
+// Rebuild recomputes the failure links for the whole trie if patterns were added or removed
+// since the last build, and does nothing otherwise. Every search already does this on demand,
+// so Rebuild is only needed to pay the construction cost up front, for example before sharing
+// the automaton between goroutines.
+func (aho *AhoCorasick) Rebuild() {
+	aho.ensureBuilt()
+}
+
+// ensureBuilt rebuilds the failure links if they are stale.
+func (aho *AhoCorasick) ensureBuilt() {
+	if !aho.built {
+		aho.BuildFailureLinks()
+	}
+}
+
+// step advances the automaton from the given node on the given character.
+// Failure links are followed until a transition exists or the root is reached.
+func (aho *AhoCorasick) step(node *Node, character rune) *Node {
+	// Follow failure links if no match.
+	for node != aho.root && node.children[character] == nil {
+		node = node.fail
+	}
+
+	// Transition to next state if possible.
+	if next, exists := node.children[character]; exists {
+		node = next
+	}
+
+	return node
+}
+
+// branch advances every partial match on the character spanning text bytes [start, end),
+// following both the literal child and the wildcard child of each node, after first starting
+// a new partial match at the root. Every pattern terminating at a reached node is emitted with
+// the start offset of its partial match. Returns the partial matches still alive.
+func (aho *AhoCorasick) branch(threads []thread, character rune, start int, end int,
+	emit func(pattern string, start int, end int)) []thread {
+	threads = append(threads, thread{node: aho.root, start: start})
+
+	var next []thread = make([]thread, 0, len(threads))
+
+	for _, current := range threads {
+		for _, child := range [2]*Node{current.node.children[character], current.node.wildcard} {
+			if child == nil {
+				continue
+			}
+
+			next = append(next, thread{node: child, start: current.start})
+
+			for _, pattern := range child.patterns {
+				emit(pattern, current.start, end)
+			}
+		}
+	}
+
+	return next
+}
+
+// scan reports every occurrence of every pattern in the text, in order of its end offset,
+// through emit. It runs the failure-link automaton, or branches through the trie while
+// wildcard patterns are registered.
+func (aho *AhoCorasick) scan(text string, emit func(pattern string, start int, end int)) {
+	var threads []thread
+	var node *Node = aho.root
+
+	aho.ensureBuilt()
+
+	for index := 0; index < len(text); {
+		character, size := utf8.DecodeRuneInString(text[index:])
+
+		// The match ends just past the current rune.
+		var end int = index + size
+
+		if aho.wildcardPatterns > 0 {
+			threads = aho.branch(threads, character, index, end, emit)
+		} else {
+			node = aho.step(node, character)
+
+			for _, pattern := range node.output {
+				emit(pattern, end-len(pattern), end)
+			}
+		}
+
+		index = end
+	}
 }
 
 // Search scans the given text for all patterns previously added to the trie.
 // Returns a map from matched pattern to list of starting indices in the text.
+// Stale failure links are rebuilt before scanning.
 func (aho *AhoCorasick) Search(text string) map[string][]int {
 	var result map[string][]int = make(map[string][]int)
 
+	// Record all matched patterns with their starting indices.
+	aho.scan(text, func(pattern string, start int, end int) {
+		result[pattern] = append(result[pattern], start)
+	})
+
+	return result
+}
+
+// SearchMinLen scans the text like Search, but omits every pattern shorter than minLen
+// characters (runes, so a multi-byte character counts once) from the result. The automaton is
+// unchanged; short patterns are still matched during the scan and only filtered from the output.
+func (aho *AhoCorasick) SearchMinLen(text string, minLen int) map[string][]int {
+	var result map[string][]int = make(map[string][]int)
+
+	aho.scan(text, func(pattern string, start int, end int) {
+		if utf8.RuneCountInString(pattern) < minLen {
+			return
+		}
+
+		result[pattern] = append(result[pattern], start)
+	})
+
+	return result
+}
+
+// SearchParallel scans the text like Search, but splits it into one segment per worker and
+// scans the segments on separate goroutines. Each worker reads past the end of its segment by
+// the longest possible match length minus one byte, so matches straddling a boundary are still
+// found, and keeps only the matches starting inside its own segment, so none is reported twice.
+// The failure links are built before the workers start and the automaton is only read while
+// they run, so it is shared without locking. The result is identical to Search, including the
+// order of the starting indices. With fewer than two workers the text is scanned sequentially.
+func (aho *AhoCorasick) SearchParallel(text string, workers int) map[string][]int {
+	if workers < 2 || len(text) < 2*workers {
+		return aho.Search(text)
+	}
+
+	aho.ensureBuilt()
+
+	// Longest match in bytes: a wildcard may match a character of up to utf8.UTFMax bytes.
+	var stats AutomatonStats = aho.Stats()
+	var overlap int = stats.MaxPatternLength - 1
+
+	if aho.wildcardPatterns > 0 {
+		overlap = stats.MaxDepth*utf8.UTFMax - 1
+	}
+
+	// Segment boundaries, moved forward onto rune starts so no rune is split.
+	var boundaries []int = make([]int, workers+1)
+
+	for worker := 1; worker < workers; worker++ {
+		var boundary int = worker * len(text) / workers
+
+		for boundary < len(text) && !utf8.RuneStart(text[boundary]) {
+			boundary++
+		}
+
+		boundaries[worker] = boundary
+	}
+
+	boundaries[workers] = len(text)
+
+	var segmentResults []map[string][]int = make([]map[string][]int, workers)
+	var waitGroup sync.WaitGroup
+
+	for worker := 0; worker < workers; worker++ {
+		waitGroup.Add(1)
+
+		go func(worker int) {
+			defer waitGroup.Done()
+
+			var start int = boundaries[worker]
+			var end int = boundaries[worker+1]
+			var windowEnd int = min(end+overlap, len(text))
+			var result map[string][]int = make(map[string][]int)
+
+			aho.scan(text[start:windowEnd], func(pattern string, matchStart int, matchEnd int) {
+				// Matches starting in the overlap belong to the next segment.
+				if start+matchStart < end {
+					result[pattern] = append(result[pattern], start+matchStart)
+				}
+			})
+
+			segmentResults[worker] = result
+		}(worker)
+	}
+
+	waitGroup.Wait()
+
+	// Segments are in text order, so concatenating keeps every index list sorted.
+	var merged map[string][]int = make(map[string][]int)
+
+	for _, result := range segmentResults {
+		for pattern, starts := range result {
+			merged[pattern] = append(merged[pattern], starts...)
+		}
+	}
+
+	return merged
+}
+
+// SearchMatches scans the given text for all patterns previously added to the trie and
+// returns every occurrence, including overlapping ones, as a Match. The results are sorted
+// by start offset, then by match length, so they can be consumed in text order.
+func (aho *AhoCorasick) SearchMatches(text string) []Match {
+	var matches []Match = []Match{}
+
+	aho.scan(text, func(pattern string, start int, end int) {
+		matches = append(matches, Match{Pattern: pattern, Start: start, End: end})
+	})
+
+	// Order by position, breaking ties by the shorter match first.
+	sort.Slice(matches, func(compare int, against int) bool {
+		if matches[compare].Start != matches[against].Start {
+			return matches[compare].Start < matches[against].Start
+		}
+
+		return matches[compare].End < matches[against].End
+	})
+
+	return matches
+}
+
+// SearchLongest scans the text like SearchMatches, but wherever several patterns end at the
+// same position (such as "she" and "he" in "ushers") only the longest of them is reported, so
+// the most specific match wins. Matches ending at different positions are all kept, even if
+// they overlap. The results are sorted like those of SearchMatches.
+func (aho *AhoCorasick) SearchLongest(text string) []Match {
+	var matches []Match = []Match{}
+
+	// Matches are emitted in order of their end offset, so all candidates for one ending
+	// position arrive together and only the last recorded match needs to be compared.
+	aho.scan(text, func(pattern string, start int, end int) {
+		var last int = len(matches) - 1
+
+		if last >= 0 && matches[last].End == end {
+			if start < matches[last].Start {
+				matches[last] = Match{Pattern: pattern, Start: start, End: end}
+			}
+
+			return
+		}
+
+		matches = append(matches, Match{Pattern: pattern, Start: start, End: end})
+	})
+
+	sort.Slice(matches, func(compare int, against int) bool {
+		if matches[compare].Start != matches[against].Start {
+			return matches[compare].Start < matches[against].Start
+		}
+
+		return matches[compare].End < matches[against].End
+	})
+
+	return matches
+}
+
+// SearchReader scans the stream read from reader for all patterns previously added to the trie.
+// The input is consumed in buffered chunks and the automaton state is carried across reads,
+// so a pattern straddling two chunks is still reported. For every match, emit is called with
+// the pattern and the absolute byte offset just past the end of the match in the stream.
+// Returns the first read error other than io.EOF.
+func (aho *AhoCorasick) SearchReader(reader io.Reader, emit func(pattern string, endOffset int)) error {
+	var bufferedReader *bufio.Reader = bufio.NewReader(reader)
+
+	aho.ensureBuilt()
+
 	var node *Node = aho.root
+	var offset int = 0
+
+	var threads []thread
+
+	// Wildcard matches are reported by end offset, like automaton matches.
+	var emitEnd = func(pattern string, start int, end int) {
+		emit(pattern, end)
+	}
+
+	for {
+		// Decode the next rune, reassembling multi-byte runes split across reads.
+		character, size, err := bufferedReader.ReadRune()
 
-	// Iterate through each rune in the input text.
-	for index, character := range text {
-		// Follow failure links if no match.
-		for node != aho.root && node.children[character] == nil {
-			node = node.fail
+		if err == io.EOF {
+			return nil
 		}
 
-		// Transition to next state if possible.
-		if next, exists := node.children[character]; exists {
-			node = next
+		if err != nil {
+			return err
+		}
+
+		if aho.wildcardPatterns > 0 {
+			threads = aho.branch(threads, character, offset, offset+size, emitEnd)
+			offset += size
+
+			continue
 		}
 
-		// Record all matched patterns at this node.
+		offset += size
+
+		node = aho.step(node, character)
+
+		// Report all matched patterns at this node.
 		for _, pattern := range node.output {
-			result[pattern] = append(result[pattern], index-len(pattern)+1)
+			emit(pattern, offset)
 		}
 	}
+}
 
-	return result
+// longestMatchAt walks the trie from the root along the text beginning at start and
+// returns the longest pattern that starts exactly there, exploring wildcard children as well
+// as literal ones. On a tie the literal path wins. The boolean result reports whether any
+// pattern matched.
+func (aho *AhoCorasick) longestMatchAt(text string, start int) (Match, bool) {
+	var best Match
+	var found bool = false
+
+	var walk func(node *Node, offset int)
+
+	walk = func(node *Node, offset int) {
+		// Remember the deepest terminal node reached so far.
+		if len(node.patterns) > 0 && (!found || offset > best.End) {
+			best = Match{Pattern: node.patterns[0], Start: start, End: offset}
+			found = true
+		}
+
+		// Stop at the end of the text; a missing child ends the walk along that path.
+		if offset >= len(text) {
+			return
+		}
+
+		character, size := utf8.DecodeRuneInString(text[offset:])
+
+		if next, exists := node.children[character]; exists {
+			walk(next, offset+size)
+		}
+
+		if node.wildcard != nil {
+			walk(node.wildcard, offset+size)
+		}
+	}
+
+	walk(aho.root, start)
+
+	return best, found
+}
+
+// SearchNonOverlapping scans the text for leftmost-longest matches that do not overlap.
+// At each position the longest pattern starting there is reported, and scanning resumes
+// just past the end of that match; positions where no pattern starts are skipped one rune
+// at a time. Returns the matches ordered by their start offset.
+func (aho *AhoCorasick) SearchNonOverlapping(text string) []Match {
+	var matches []Match = []Match{}
+
+	var position int = 0
+
+	for position < len(text) {
+		if match, found := aho.longestMatchAt(text, position); found {
+			matches = append(matches, match)
+
+			// Resume scanning after the reported match.
+			position = match.End
+
+			continue
+		}
+
+		// No pattern starts here, advance to the next rune.
+		_, size := utf8.DecodeRuneInString(text[position:])
+
+		position += size
+	}
+
+	return matches
+}
+
+// ReplaceFunc returns a copy of the text in which every non-overlapping leftmost-longest
+// match is replaced by the string returned from replace for that match. Text between
+// matches, including adjacent and back-to-back matches, is copied through unchanged.
+func (aho *AhoCorasick) ReplaceFunc(text string, replace func(match Match) string) string {
+	var builder strings.Builder
+
+	var previousEnd int = 0
+
+	for _, match := range aho.SearchNonOverlapping(text) {
+		// Copy the untouched text preceding this match.
+		builder.WriteString(text[previousEnd:match.Start])
+		builder.WriteString(replace(match))
+
+		previousEnd = match.End
+	}
+
+	// Copy whatever follows the final match.
+	builder.WriteString(text[previousEnd:])
+
+	return builder.String()
+}
+
+// Highlight returns a copy of the text in which every non-overlapping leftmost-longest match
+// is wrapped in the open and close delimiters, such as "<b>" and "</b>". Overlapping matches
+// are resolved exactly as in SearchNonOverlapping, so the wrapped spans tile the text and are
+// never nested; adjacent matches are wrapped separately. Non-matching text is left untouched.
+func (aho *AhoCorasick) Highlight(text string, open string, close string) string {
+	return aho.ReplaceFunc(text, func(match Match) string {
+		return open + text[match.Start:match.End] + close
+	})
+}
+
+// Stats walks the trie and returns its size statistics. It only inspects trie structure,
+// so it may be called before or after BuildFailureLinks.
+func (aho *AhoCorasick) Stats() AutomatonStats {
+	var stats AutomatonStats
+
+	var visit func(node *Node, depth int)
+
+	visit = func(node *Node, depth int) {
+		stats.NodeCount++
+
+		if depth > stats.MaxDepth {
+			stats.MaxDepth = depth
+		}
+
+		// Every terminal node corresponds to exactly one distinct pattern.
+		if len(node.patterns) > 0 {
+			stats.PatternCount++
+
+			if len(node.patterns[0]) > stats.MaxPatternLength {
+				stats.MaxPatternLength = len(node.patterns[0])
+			}
+		}
+
+		for _, child := range node.children {
+			visit(child, depth+1)
+		}
+
+		if node.wildcard != nil {
+			visit(node.wildcard, depth+1)
+		}
+	}
+
+	visit(aho.root, 0)
+
+	return stats
+}
+
+// ToDOT writes the trie to w as a Graphviz digraph for debugging. Nodes are numbered in
+// breadth-first order from the root (node 0) and labelled with the prefix they spell. Child
+// transitions are drawn as solid edges labelled with their character, wildcard transitions as
+// bold edges labelled with the wildcard rune, and failure links as dashed edges. Nodes with a
+// non-empty output are drawn as double circles. Stale failure links are rebuilt first, so the
+// dump always reflects what a search would use. Errors returned by w are ignored; render to a
+// strings.Builder or bytes.Buffer to inspect the output.
+func (aho *AhoCorasick) ToDOT(w io.Writer) {
+	aho.ensureBuilt()
+
+	var identifiers map[*Node]int = map[*Node]int{aho.root: 0}
+	var prefixes map[*Node]string = map[*Node]string{aho.root: ""}
+	var order []*Node = []*Node{aho.root}
+
+	// Number the nodes breadth first, visiting children in character order so the output is stable.
+	for index := 0; index < len(order); index++ {
+		var node *Node = order[index]
+
+		for _, character := range sortedCharacters(node) {
+			var child *Node = node.children[character]
+
+			identifiers[child] = len(order)
+			prefixes[child] = prefixes[node] + string(character)
+			order = append(order, child)
+		}
+
+		if node.wildcard != nil {
+			identifiers[node.wildcard] = len(order)
+			prefixes[node.wildcard] = prefixes[node] + string(aho.wildcard)
+			order = append(order, node.wildcard)
+		}
+	}
+
+	fmt.Fprintln(w, "digraph AhoCorasick {")
+	fmt.Fprintln(w, "\trankdir=LR;")
+
+	for _, node := range order {
+		var shape string = "circle"
+
+		if len(node.output) > 0 {
+			shape = "doublecircle"
+		}
+
+		fmt.Fprintf(w, "\tn%d [label=%q, shape=%s];\n", identifiers[node], prefixes[node], shape)
+	}
+
+	for _, node := range order {
+		for _, character := range sortedCharacters(node) {
+			fmt.Fprintf(w, "\tn%d -> n%d [label=%q];\n", identifiers[node], identifiers[node.children[character]],
+				string(character))
+		}
+
+		if node.wildcard != nil {
+			fmt.Fprintf(w, "\tn%d -> n%d [label=%q, style=bold];\n", identifiers[node], identifiers[node.wildcard],
+				string(aho.wildcard))
+		}
+	}
+
+	// Wildcard nodes take no part in the failure-link automaton and have no failure link.
+	for _, node := range order {
+		if node != aho.root && node.fail != nil {
+			fmt.Fprintf(w, "\tn%d -> n%d [style=dashed];\n", identifiers[node], identifiers[node.fail])
+		}
+	}
+
+	fmt.Fprintln(w, "}")
+}
+
+// sortedCharacters returns the characters of the node's literal children in ascending order.
+func sortedCharacters(node *Node) []rune {
+	var characters []rune = make([]rune, 0, len(node.children))
+
+	for character := range node.children {
+		characters = append(characters, character)
+	}
+
+	sort.Slice(characters, func(compare int, against int) bool {
+		return characters[compare] < characters[against]
+	})
+
+	return characters
 }
@@ -11,8 +11,16 @@
 //
 //	- Adding single and multiple patterns
 //	- Building failure links for fallback transitions
+//	- Adding patterns after a build, with stale links rebuilt on demand
 //	- Performing pattern matching over varied input strings
 //	- Handling empty inputs and overlapping matches
+//	- Single-character wildcard patterns alongside literal patterns
+//	- Highlighting matches with delimiters
+//	- Longest-match-only results at shared ending positions
+//	- Filtering out matches of patterns shorter than a minimum length
+//	- Dumping the trie and failure links as a Graphviz digraph
+//	- Concurrent search across overlapping text segments
+//	- The byte-indexed automaton agreeing with the rune automaton on ASCII text
 //
 //	All tests use Go’s standard "testing" package.
 //
@@ -26,18 +34,53 @@
 //	✅ TestNoMatches                         — Ensures no false positives in unmatched text
 //	✅ TestOverlappingPatterns               — Tests behavior with nested and overlapping patterns
 //	✅ TestEmptyPatternsAndText              — Verifies handling of empty pattern and text edge cases
+//	✅ TestSearchReaderMatchesSearch         — Streaming one byte at a time agrees with Search
+//	✅ TestRemovePattern                     — Removed patterns stop matching after rebuild
+//	✅ TestRemovePatternMissing              — Removing an unknown pattern reports false
+//	✅ TestSearchNonOverlapping              — Leftmost-longest matches tile the text without overlap
+//	✅ TestSearchNonOverlappingLeftmost      — Earlier starts win over earlier-ending matches
+//	✅ TestSearchMatchesOrdered              — Structured matches sorted by start then length
+//	✅ TestReplaceFuncMasksMatches           — Redacts matches while preserving surrounding text
+//	✅ TestAddPatternRejectsEmpty            — Empty patterns are rejected without side effects
+//	✅ TestStats                             — Node count, pattern count, and depth before and after build
+//	✅ TestSearchBuildsFailureLinks          — Search without an explicit build still matches correctly
+//	✅ TestAddPatternAfterBuild              — Patterns added after a build are found by every search and Rebuild
+//	✅ TestWildcardMatchesSingleCharacter    — "h?llo" matches "hello" and "hallo" but not "hllo"
+//	✅ TestWildcardWithLiteralPatterns       — Wildcard and literal patterns coexist in every search mode
+//	✅ TestSetWildcard                       — A custom wildcard rune, with '?' then matched literally
+//	✅ TestHighlight                         — Adjacent, overlapping, and nested matches wrapped exactly once
+//	✅ TestSearchParallelMatchesSearch       — Parallel results equal Search for many worker counts
+//	✅ TestSearchLongest                     — Only the longest match survives at each ending position
+//	✅ TestSearchMinLen                      — Patterns shorter than minLen runes are left out of the results
+//	✅ TestToDOT                             — DOT node, edge, and output-node counts match the trie
+//	✅ TestByteAhoCorasickMatchesSearch      — Byte automaton results equal Search on ASCII text
+//	✅ TestByteAhoCorasickRejectsEmpty       — Empty byte patterns are rejected without side effects
+//
+// Benchmarks:
+//
+//	⏱ BenchmarkSearch                        — Single-threaded scan of a large text
+//	⏱ BenchmarkSearchParallel                — Segmented scan with one worker per CPU
+//	⏱ BenchmarkSearchASCII                   — Rune automaton scan of a large ASCII text
+//	⏱ BenchmarkByteSearchASCII               — Byte automaton scan of the same ASCII text
 //
 // Usage:
 //
 //	To run all tests:
 //	$ go test -v
 //
+//	To run the benchmarks:
+//	$ go test -bench .
+//
 // ===================================================================================
 package ahocorasickimplementation
 
 import (
+	"math/rand"
 	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+	"testing/iotest"
 )
 
 // TestAddPatternAndSearchSingle tests matching a single pattern ("he") in a basic text input.
@@ -180,3 +223,852 @@ func TestEmptyPatternsAndText(test *testing.T) {
 		test.Errorf("Search() with empty pattern = %v; want empty map.", result)
 	}
 }
+
+// TestSearchReaderMatchesSearch feeds the text through a reader that returns one byte per read,
+// forcing every pattern to straddle read boundaries, and confirms the results match Search.
+func TestSearchReaderMatchesSearch(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"he", "she", "his", "hers"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	ahoCorasick.BuildFailureLinks()
+
+	var text string = "ushers said his sheep hid here"
+
+	var expected map[string][]int = ahoCorasick.Search(text)
+
+	var result map[string][]int = make(map[string][]int)
+
+	// Act.
+	var err error = ahoCorasick.SearchReader(iotest.OneByteReader(strings.NewReader(text)), func(pattern string, endOffset int) {
+		result[pattern] = append(result[pattern], endOffset-len(pattern))
+	})
+
+	// Assert.
+	if err != nil {
+		test.Fatalf("SearchReader() returned error: %v.", err)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("SearchReader() = %v; want %v.", result, expected)
+	}
+}
+
+// TestRemovePattern adds three patterns, removes one, and confirms only the remaining two match.
+func TestRemovePattern(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"he", "she", "hers"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	ahoCorasick.BuildFailureLinks()
+
+	var expected map[string][]int = map[string][]int{
+		"she": {1},
+		"he":  {2},
+	}
+
+	// Act.
+	var removed bool = ahoCorasick.RemovePattern("hers")
+
+	ahoCorasick.BuildFailureLinks()
+
+	var result map[string][]int = ahoCorasick.Search("ushers")
+
+	// Assert.
+	if !removed {
+		test.Error("RemovePattern() = false; want true.")
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() = %v; want %v.", result, expected)
+	}
+
+	// The now-dead "r" and "s" nodes below "he" should have been pruned.
+	if len(ahoCorasick.root.children['h'].children['e'].children) != 0 {
+		test.Error("Expected nodes below 'he' to be pruned after removal.")
+	}
+}
+
+// TestRemovePatternMissing verifies that removing a pattern that was never added, or only
+// exists as a prefix of another pattern, reports false and leaves matching intact.
+func TestRemovePatternMissing(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	ahoCorasick.AddPattern("hers")
+
+	var expected map[string][]int = map[string][]int{
+		"hers": {2},
+	}
+
+	// Act.
+	var removedMissing bool = ahoCorasick.RemovePattern("xyz")
+	var removedPrefix bool = ahoCorasick.RemovePattern("he")
+
+	// Search without an explicit rebuild.
+	var result map[string][]int = ahoCorasick.Search("ushers")
+
+	// Assert.
+	if removedMissing || removedPrefix {
+		test.Errorf("RemovePattern() = %v, %v; want false, false.", removedMissing, removedPrefix)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() = %v; want %v.", result, expected)
+	}
+}
+
+// TestSearchNonOverlapping verifies that overlapping patterns produce a clean
+// non-overlapping tiling, preferring the longest pattern at each position.
+func TestSearchNonOverlapping(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"ab", "abc", "bc"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var expected []Match = []Match{
+		{Pattern: "abc", Start: 0, End: 3},
+		{Pattern: "bc", Start: 3, End: 5},
+	}
+
+	// Act.
+	var result []Match = ahoCorasick.SearchNonOverlapping("abcbc")
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("SearchNonOverlapping() = %v; want %v.", result, expected)
+	}
+}
+
+// TestSearchNonOverlappingLeftmost verifies that a longer match starting earlier
+// wins over a shorter one nested inside it, and that unmatched text is skipped.
+func TestSearchNonOverlappingLeftmost(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"abcd", "bc"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var expected []Match = []Match{
+		{Pattern: "abcd", Start: 1, End: 5},
+		{Pattern: "bc", Start: 6, End: 8},
+	}
+
+	// Act.
+	var result []Match = ahoCorasick.SearchNonOverlapping("xabcdxbc")
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("SearchNonOverlapping() = %v; want %v.", result, expected)
+	}
+}
+
+// TestSearchMatchesOrdered verifies that SearchMatches returns every occurrence with start
+// and end offsets, sorted by start offset and then by pattern length.
+func TestSearchMatchesOrdered(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"he", "she", "his", "hers"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	ahoCorasick.BuildFailureLinks()
+
+	var expected []Match = []Match{
+		{Pattern: "she", Start: 1, End: 4},
+		{Pattern: "he", Start: 2, End: 4},
+		{Pattern: "hers", Start: 2, End: 6},
+	}
+
+	// Act.
+	var result []Match = ahoCorasick.SearchMatches("ushers")
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("SearchMatches() = %v; want %v.", result, expected)
+	}
+}
+
+// TestReplaceFuncMasksMatches masks credit-card-like numbers with asterisks, covering
+// back-to-back matches and verifying that the text between matches is preserved exactly.
+func TestReplaceFuncMasksMatches(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"4111-1111-1111-1111", "5500-0000-0000-0004"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var text string = "visa=4111-1111-1111-1111;mc=5500-0000-0000-00045500-0000-0000-0004 end"
+
+	var expected string = "visa=*******************;mc=************************************** end"
+
+	// Act.
+	var result string = ahoCorasick.ReplaceFunc(text, func(match Match) string {
+		return strings.Repeat("*", len(match.Pattern))
+	})
+
+	// Assert.
+	if result != expected {
+		test.Errorf("ReplaceFunc() = %q; want %q.", result, expected)
+	}
+}
+
+// TestAddPatternRejectsEmpty verifies that adding an empty pattern returns ErrEmptyPattern and
+// that real patterns added afterwards still match without any spurious empty-pattern entries.
+func TestAddPatternRejectsEmpty(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var expected map[string][]int = map[string][]int{
+		"she": {1},
+		"he":  {2},
+	}
+
+	// Act.
+	var err error = ahoCorasick.AddPattern("")
+
+	ahoCorasick.AddPattern("he")
+	ahoCorasick.AddPattern("she")
+
+	ahoCorasick.BuildFailureLinks()
+
+	var result map[string][]int = ahoCorasick.Search("ushe")
+
+	// Assert.
+	if err != ErrEmptyPattern {
+		test.Errorf("AddPattern(\"\") error = %v; want %v.", err, ErrEmptyPattern)
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() = %v; want %v.", result, expected)
+	}
+}
+
+// TestStats inserts known patterns and asserts the reported trie statistics, both before and
+// after the failure links are built.
+func TestStats(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	// Trie nodes: root, h, he, her, hers, hi, his, s, sh, she. The duplicate "he" is not distinct.
+	var patterns []string = []string{"he", "she", "his", "hers", "he"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var expected AutomatonStats = AutomatonStats{
+		NodeCount:        10,
+		PatternCount:     4,
+		MaxPatternLength: 4,
+		MaxDepth:         4,
+	}
+
+	// Act.
+	var before AutomatonStats = ahoCorasick.Stats()
+
+	ahoCorasick.BuildFailureLinks()
+
+	var after AutomatonStats = ahoCorasick.Stats()
+
+	// Assert.
+	if before != expected {
+		test.Errorf("Stats() before build = %+v; want %+v.", before, expected)
+	}
+
+	if after != expected {
+		test.Errorf("Stats() after build = %+v; want %+v.", after, expected)
+	}
+}
+
+// TestSearchBuildsFailureLinks verifies that Search builds the failure links on demand,
+// both when BuildFailureLinks was never called and when a pattern is added after a build.
+func TestSearchBuildsFailureLinks(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"he", "she", "his", "hers"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var expected map[string][]int = map[string][]int{
+		"she":  {1},
+		"he":   {2},
+		"hers": {2},
+	}
+
+	// Act.
+	var result map[string][]int = ahoCorasick.Search("ushers")
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() without build = %v; want %v.", result, expected)
+	}
+
+	// Arrange.
+	ahoCorasick.AddPattern("rs")
+
+	expected["rs"] = []int{4}
+
+	// Act.
+	result = ahoCorasick.Search("ushers")
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() after adding a pattern = %v; want %v.", result, expected)
+	}
+}
+
+// TestAddPatternAfterBuild builds and searches, adds a pattern, and checks that every search
+// mode as well as an explicit Rebuild picks up the new pattern.
+func TestAddPatternAfterBuild(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	for _, pattern := range []string{"he", "she"} {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	ahoCorasick.BuildFailureLinks()
+
+	if result := ahoCorasick.Search("ushers"); result["hers"] != nil {
+		test.Fatalf("Search() before adding %q = %v; want no match for it.", "hers", result)
+	}
+
+	// Act.
+	ahoCorasick.AddPattern("hers")
+
+	var result map[string][]int = ahoCorasick.Search("ushers")
+	var matches []Match = ahoCorasick.SearchMatches("ushers")
+	var ends []int
+
+	ahoCorasick.AddPattern("ers")
+
+	err := ahoCorasick.SearchReader(strings.NewReader("ushers"), func(pattern string, endOffset int) {
+		if pattern == "ers" {
+			ends = append(ends, endOffset)
+		}
+	})
+
+	// Assert.
+	if !reflect.DeepEqual(result["hers"], []int{2}) {
+		test.Errorf("Search() after adding %q = %v; want it at [2].", "hers", result)
+	}
+
+	if !reflect.DeepEqual(matches[len(matches)-1], Match{Pattern: "hers", Start: 2, End: 6}) {
+		test.Errorf("SearchMatches() after adding %q = %v; want it last.", "hers", matches)
+	}
+
+	if err != nil || !reflect.DeepEqual(ends, []int{6}) {
+		test.Errorf("SearchReader() after adding %q reported ends %v, %v; want [6], nil.", "ers", ends, err)
+	}
+
+	// Act.
+	ahoCorasick.AddPattern("us")
+	ahoCorasick.Rebuild()
+
+	// Assert.
+	if !ahoCorasick.built {
+		test.Error("Expected Rebuild to leave the failure links up to date.")
+	}
+
+	if result := ahoCorasick.Search("ushers"); !reflect.DeepEqual(result["us"], []int{0}) {
+		test.Errorf("Search() after Rebuild = %v; want %q at [0].", result, "us")
+	}
+}
+
+// TestWildcardMatchesSingleCharacter verifies that a wildcard stands for exactly one character,
+// including a multi-byte one, and never for zero characters.
+func TestWildcardMatchesSingleCharacter(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	ahoCorasick.AddPatternWithWildcard("h?llo")
+
+	var tests = []struct {
+		text     string
+		expected []Match
+	}{
+		{text: "hello", expected: []Match{{Pattern: "h?llo", Start: 0, End: 5}}},
+		{text: "say hallo", expected: []Match{{Pattern: "h?llo", Start: 4, End: 9}}},
+		{text: "héllo", expected: []Match{{Pattern: "h?llo", Start: 0, End: 6}}},
+		{text: "hllo", expected: []Match{}},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var result []Match = ahoCorasick.SearchMatches(specificTest.text)
+
+		// Assert.
+		if !reflect.DeepEqual(result, specificTest.expected) {
+			test.Errorf("SearchMatches(%q) = %v; want %v.", specificTest.text, result, specificTest.expected)
+		}
+	}
+}
+
+// TestWildcardWithLiteralPatterns mixes a wildcard pattern with literal ones and checks Search,
+// SearchReader, and SearchNonOverlapping all report the same occurrences.
+func TestWildcardWithLiteralPatterns(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	ahoCorasick.AddPattern("he")
+	ahoCorasick.AddPattern("hers")
+	ahoCorasick.AddPatternWithWildcard("r?n")
+
+	var text string = "ushers ran the run"
+
+	var expected map[string][]int = map[string][]int{
+		"he":   {2, 12},
+		"hers": {2},
+		"r?n":  {7, 15},
+	}
+
+	var streamed map[string][]int = make(map[string][]int)
+
+	// Act.
+	var result map[string][]int = ahoCorasick.Search(text)
+
+	var err error = ahoCorasick.SearchReader(iotest.OneByteReader(strings.NewReader(text)), func(pattern string, endOffset int) {
+		streamed[pattern] = append(streamed[pattern], endOffset-len(pattern))
+	})
+
+	var tiling []Match = ahoCorasick.SearchNonOverlapping(text)
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() = %v; want %v.", result, expected)
+	}
+
+	if err != nil || !reflect.DeepEqual(streamed, expected) {
+		test.Errorf("SearchReader() = %v, %v; want %v, nil.", streamed, err, expected)
+	}
+
+	var expectedTiling []Match = []Match{
+		{Pattern: "hers", Start: 2, End: 6},
+		{Pattern: "r?n", Start: 7, End: 10},
+		{Pattern: "he", Start: 12, End: 14},
+		{Pattern: "r?n", Start: 15, End: 18},
+	}
+
+	if !reflect.DeepEqual(tiling, expectedTiling) {
+		test.Errorf("SearchNonOverlapping() = %v; want %v.", tiling, expectedTiling)
+	}
+}
+
+// TestSetWildcard switches the wildcard to '*' and verifies that '?' in later patterns is then
+// matched literally, while the wildcard and a literal pattern sharing a prefix stay distinct.
+func TestSetWildcard(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	ahoCorasick.SetWildcard('*')
+	ahoCorasick.AddPatternWithWildcard("a*c")
+	ahoCorasick.AddPatternWithWildcard("x?")
+
+	var expected map[string][]int = map[string][]int{
+		"a*c": {0, 4},
+		"x?":  {11},
+	}
+
+	// Act.
+	var result map[string][]int = ahoCorasick.Search("abc a*c xy x?")
+
+	// Assert.
+	if !reflect.DeepEqual(result, expected) {
+		test.Errorf("Search() = %v; want %v.", result, expected)
+	}
+}
+
+// TestHighlight wraps matches in <b> tags and checks adjacent matches, overlapping candidates
+// resolved to a clean tiling, patterns nested inside longer ones, and text without matches.
+func TestHighlight(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"he", "hers", "she", "ab", "cd"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var tests = []struct {
+		text     string
+		expected string
+	}{
+		{text: "abcd", expected: "<b>ab</b><b>cd</b>"},
+		{text: "ushers", expected: "u<b>she</b>rs"},
+		{text: "hers here", expected: "<b>hers</b> <b>he</b>re"},
+		{text: "xyz", expected: "xyz"},
+		{text: "", expected: ""},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var result string = ahoCorasick.Highlight(specificTest.text, "<b>", "</b>")
+
+		// Assert.
+		if result != specificTest.expected {
+			test.Errorf("Highlight(%q) = %q; want %q.", specificTest.text, result, specificTest.expected)
+		}
+
+		if strings.Count(result, "<b>") != strings.Count(result, "</b>") || strings.Contains(result, "<b><b>") {
+			test.Errorf("Highlight(%q) = %q has unbalanced or nested delimiters.", specificTest.text, result)
+		}
+	}
+}
+
+// TestSearchLongest verifies that where several patterns end at the same position only the
+// longest is reported, while matches with distinct ending positions are all kept.
+func TestSearchLongest(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	var patterns []string = []string{"he", "hers", "her", "she", "rs"}
+
+	for _, pattern := range patterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var tests = []struct {
+		text     string
+		expected []Match
+	}{
+		{
+			// "he" is dropped in favour of "she", and "rs" in favour of "hers".
+			text: "ushers",
+			expected: []Match{
+				{Pattern: "she", Start: 1, End: 4},
+				{Pattern: "her", Start: 2, End: 5},
+				{Pattern: "hers", Start: 2, End: 6},
+			},
+		},
+		{
+			// Nested prefixes end at distinct positions, so each one is kept.
+			text: "hers",
+			expected: []Match{
+				{Pattern: "he", Start: 0, End: 2},
+				{Pattern: "her", Start: 0, End: 3},
+				{Pattern: "hers", Start: 0, End: 4},
+			},
+		},
+		{text: "xyz", expected: []Match{}},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var result []Match = ahoCorasick.SearchLongest(specificTest.text)
+
+		// Assert.
+		if !reflect.DeepEqual(result, specificTest.expected) {
+			test.Errorf("SearchLongest(%q) = %v; want %v.", specificTest.text, result, specificTest.expected)
+		}
+	}
+}
+
+// TestSearchMinLen checks that patterns shorter than the minimum length are dropped from the
+// results while longer ones keep every occurrence reported by Search.
+func TestSearchMinLen(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	for _, pattern := range []string{"a", "ab", "abc", "日本"} {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var tests = []struct {
+		text     string
+		minLen   int
+		expected map[string][]int
+	}{
+		{text: "abcab", minLen: 2, expected: map[string][]int{"ab": {0, 3}, "abc": {0}}},
+		{text: "abcab", minLen: 3, expected: map[string][]int{"abc": {0}}},
+		{text: "abcab", minLen: 4, expected: map[string][]int{}},
+		{text: "abcab", minLen: 0, expected: map[string][]int{"a": {0, 3}, "ab": {0, 3}, "abc": {0}}},
+		{
+			// "日本" is two runes long even though it spans six bytes.
+			text:     "a日本",
+			minLen:   2,
+			expected: map[string][]int{"日本": {1}},
+		},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var result map[string][]int = ahoCorasick.SearchMinLen(specificTest.text, specificTest.minLen)
+
+		// Assert.
+		if !reflect.DeepEqual(result, specificTest.expected) {
+			test.Errorf("SearchMinLen(%q, %d) = %v; want %v.", specificTest.text, specificTest.minLen, result,
+				specificTest.expected)
+		}
+	}
+}
+
+// TestToDOT checks that the DOT dump has one node per trie state, one solid edge per child
+// transition, one dashed edge per failure link, and marks exactly the output nodes.
+func TestToDOT(test *testing.T) {
+	// Arrange.
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	for _, pattern := range []string{"he", "she", "his", "hers"} {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	ahoCorasick.BuildFailureLinks()
+
+	var stats AutomatonStats = ahoCorasick.Stats()
+	var builder strings.Builder
+
+	// Act.
+	ahoCorasick.ToDOT(&builder)
+
+	// Assert.
+	var dot string = builder.String()
+
+	if !strings.HasPrefix(dot, "digraph AhoCorasick {") || !strings.HasSuffix(dot, "}\n") {
+		test.Fatalf("ToDOT output is not a digraph:\n%s", dot)
+	}
+
+	var nodes int = strings.Count(dot, "shape=")
+	var outputNodes int = strings.Count(dot, "shape=doublecircle")
+	var childEdges int = strings.Count(dot, "-> ") - strings.Count(dot, "style=dashed")
+	var failureEdges int = strings.Count(dot, "style=dashed")
+
+	// Root, h, he, her, hers, hi, his, s, sh, she.
+	if nodes != stats.NodeCount || nodes != 10 {
+		test.Errorf("ToDOT emitted %d nodes; want %d.", nodes, stats.NodeCount)
+	}
+
+	// Every node except the root has one incoming child edge and one failure link.
+	if childEdges != stats.NodeCount-1 {
+		test.Errorf("ToDOT emitted %d child edges; want %d.", childEdges, stats.NodeCount-1)
+	}
+
+	if failureEdges != stats.NodeCount-1 {
+		test.Errorf("ToDOT emitted %d failure links; want %d.", failureEdges, stats.NodeCount-1)
+	}
+
+	// "he", "she", "his", and "hers" each end at a distinct output node.
+	if outputNodes != stats.PatternCount {
+		test.Errorf("ToDOT marked %d output nodes; want %d.", outputNodes, stats.PatternCount)
+	}
+
+	// "her" extends "he", and the failure link of "she" leads to "he".
+	for _, edge := range []string{`n3 -> n6 [label="r"];`, `n8 -> n3 [style=dashed];`} {
+		if !strings.Contains(dot, edge) {
+			test.Errorf("ToDOT output is missing %s:\n%s", edge, dot)
+		}
+	}
+}
+
+// randomText builds a text of the given length over a small alphabet, including a multi-byte
+// rune, so that patterns occur often and frequently straddle segment boundaries.
+func randomText(length int, seed int64) string {
+	var random *rand.Rand = rand.New(rand.NewSource(seed))
+	var alphabet []rune = []rune("aabhers é")
+	var builder strings.Builder
+
+	for index := 0; index < length; index++ {
+		builder.WriteRune(alphabet[random.Intn(len(alphabet))])
+	}
+
+	return builder.String()
+}
+
+// TestSearchParallelMatchesSearch compares SearchParallel with Search for literal and wildcard
+// pattern sets across a range of worker counts, including more workers than characters.
+func TestSearchParallelMatchesSearch(test *testing.T) {
+	// Arrange.
+	var literal *AhoCorasick = NewAhoCorasick()
+	var wildcard *AhoCorasick = NewAhoCorasick()
+
+	for _, pattern := range []string{"he", "she", "hers", "aab", "é", "aaaa", "bé h"} {
+		literal.AddPattern(pattern)
+		wildcard.AddPattern(pattern)
+	}
+
+	wildcard.AddPatternWithWildcard("h?r")
+
+	var texts []string = []string{randomText(5000, 1), randomText(37, 2), "ab"}
+
+	for _, ahoCorasick := range []*AhoCorasick{literal, wildcard} {
+		for _, text := range texts {
+			var expected map[string][]int = ahoCorasick.Search(text)
+
+			for _, workers := range []int{0, 1, 2, 3, 7, 16, 100} {
+				// Act.
+				var result map[string][]int = ahoCorasick.SearchParallel(text, workers)
+
+				// Assert.
+				if !reflect.DeepEqual(result, expected) {
+					test.Fatalf("SearchParallel(%d workers) on %d bytes differs from Search:\n%v\nwant\n%v.", workers,
+						len(text), result, expected)
+				}
+			}
+		}
+	}
+}
+
+// benchmarkAutomaton returns an automaton over common English fragments and a 1 MB text.
+func benchmarkAutomaton() (*AhoCorasick, string) {
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	for _, pattern := range []string{"he", "she", "his", "hers", "aab", "bear", "shear"} {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	ahoCorasick.BuildFailureLinks()
+
+	return ahoCorasick, randomText(1<<20, 3)
+}
+
+// BenchmarkSearch measures a single-threaded scan of a 1 MB text.
+func BenchmarkSearch(benchmark *testing.B) {
+	ahoCorasick, text := benchmarkAutomaton()
+
+	benchmark.ResetTimer()
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		ahoCorasick.Search(text)
+	}
+}
+
+// BenchmarkSearchParallel measures a segmented scan of a 1 MB text with one worker per CPU.
+func BenchmarkSearchParallel(benchmark *testing.B) {
+	ahoCorasick, text := benchmarkAutomaton()
+
+	benchmark.ResetTimer()
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		ahoCorasick.SearchParallel(text, runtime.NumCPU())
+	}
+}
+
+// randomASCIIText builds a text of the given length over a small ASCII alphabet.
+func randomASCIIText(length int, seed int64) string {
+	var random *rand.Rand = rand.New(rand.NewSource(seed))
+	var alphabet string = "aabhers .,"
+	var builder strings.Builder
+
+	for index := 0; index < length; index++ {
+		builder.WriteByte(alphabet[random.Intn(len(alphabet))])
+	}
+
+	return builder.String()
+}
+
+// asciiPatterns are shared by the byte automaton tests and benchmarks.
+var asciiPatterns []string = []string{"he", "she", "his", "hers", "aab", "bear", "shear", "a", "s. h"}
+
+// TestByteAhoCorasickMatchesSearch compares the byte automaton with the rune automaton on
+// random ASCII texts, including patterns added after a first search.
+func TestByteAhoCorasickMatchesSearch(test *testing.T) {
+	// Arrange.
+	var runeAutomaton *AhoCorasick = NewAhoCorasick()
+	var byteAutomaton *ByteAhoCorasick = NewByteAhoCorasick()
+
+	for _, pattern := range asciiPatterns {
+		runeAutomaton.AddPattern(pattern)
+		byteAutomaton.AddPattern([]byte(pattern))
+	}
+
+	var texts []string = []string{randomASCIIText(5000, 1), randomASCIIText(37, 2), "ushers", ""}
+
+	for round := 0; round < 2; round++ {
+		for _, text := range texts {
+			// Act.
+			var result map[string][]int = byteAutomaton.Search([]byte(text))
+
+			// Assert.
+			if expected := runeAutomaton.Search(text); !reflect.DeepEqual(result, expected) {
+				test.Fatalf("Round %d: byte Search on %d bytes differs from Search:\n%v\nwant\n%v.", round, len(text),
+					result, expected)
+			}
+		}
+
+		// Adding a pattern must invalidate the failure links of both automata.
+		runeAutomaton.AddPattern("rs.")
+		byteAutomaton.AddPattern([]byte("rs."))
+	}
+}
+
+// TestByteAhoCorasickRejectsEmpty verifies that an empty byte pattern is rejected and never
+// matches.
+func TestByteAhoCorasickRejectsEmpty(test *testing.T) {
+	// Arrange.
+	var byteAutomaton *ByteAhoCorasick = NewByteAhoCorasick()
+
+	// Act.
+	var err error = byteAutomaton.AddPattern([]byte{})
+
+	// Assert.
+	if err != ErrEmptyPattern {
+		test.Errorf("Expected ErrEmptyPattern, got %v.", err)
+	}
+
+	if result := byteAutomaton.Search([]byte("text")); len(result) != 0 {
+		test.Errorf("Expected no matches, got %v.", result)
+	}
+}
+
+// BenchmarkSearchASCII measures a rune automaton scan of a 1 MB ASCII text.
+func BenchmarkSearchASCII(benchmark *testing.B) {
+	var ahoCorasick *AhoCorasick = NewAhoCorasick()
+
+	for _, pattern := range asciiPatterns {
+		ahoCorasick.AddPattern(pattern)
+	}
+
+	var text string = randomASCIIText(1<<20, 3)
+
+	ahoCorasick.BuildFailureLinks()
+	benchmark.ResetTimer()
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		ahoCorasick.Search(text)
+	}
+}
+
+// BenchmarkByteSearchASCII measures a byte automaton scan of the same 1 MB ASCII text.
+func BenchmarkByteSearchASCII(benchmark *testing.B) {
+	var ahoCorasick *ByteAhoCorasick = NewByteAhoCorasick()
+
+	for _, pattern := range asciiPatterns {
+		ahoCorasick.AddPattern([]byte(pattern))
+	}
+
+	var text []byte = []byte(randomASCIIText(1<<20, 3))
+
+	ahoCorasick.BuildFailureLinks()
+	benchmark.ResetTimer()
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		ahoCorasick.Search(text)
+	}
+}
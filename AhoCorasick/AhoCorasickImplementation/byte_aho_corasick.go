@@ -0,0 +1,160 @@
+// ===================================================================================
+// File:        byte_aho_corasick.go
+// Package:     aho
+// Description: This file implements a byte-oriented variant of the Aho-Corasick
+//
+//	automaton for ASCII-heavy workloads. Every trie node stores its children
+//	in a fixed array indexed by byte, so each transition is a single array
+//	lookup instead of a map access, at the cost of 256 pointers per node.
+//
+//	Features implemented in this file:
+//	- Trie-based insertion of byte patterns (empty patterns are rejected)
+//	- Failure link construction, built on demand when stale
+//	- Overlapping multi-pattern search over byte slices, with results in the
+//	  same form as AhoCorasick.Search
+//
+// Author:      Braiden Gole
+// Created:     July 19, 2025
+//
+// ===================================================================================
+package ahocorasickimplementation
+
+// byteNode represents a single state in the byte-oriented trie. The patterns slice holds only
+// the patterns terminating at this node, while output also includes the patterns inherited
+// through the failure link.
+type byteNode struct {
+	children [256]*byteNode
+	fail     *byteNode
+	patterns []string
+	output   []string
+}
+
+// ByteAhoCorasick is an Aho-Corasick automaton whose transitions are indexed by byte. For
+// ASCII patterns and text it reports exactly the same matches as AhoCorasick, trading memory
+// for faster transitions. The built flag records whether the failure links reflect the
+// current trie.
+type ByteAhoCorasick struct {
+	root  *byteNode
+	built bool
+}
+
+// NewByteAhoCorasick initializes and returns a new, empty byte-oriented automaton.
+func NewByteAhoCorasick() *ByteAhoCorasick {
+	return &ByteAhoCorasick{
+		root: &byteNode{output: []string{}},
+	}
+}
+
+// AddPattern inserts a pattern into the trie, byte by byte.
+// Adding a pattern marks the failure links as stale so the next search rebuilds them.
+// Returns ErrEmptyPattern, leaving the trie unchanged, if the pattern is empty.
+func (aho *ByteAhoCorasick) AddPattern(pattern []byte) error {
+	if len(pattern) == 0 {
+		return ErrEmptyPattern
+	}
+
+	var node *byteNode = aho.root
+
+	// Traverse (or build) down the trie based on each byte in the pattern.
+	for _, character := range pattern {
+		if node.children[character] == nil {
+			node.children[character] = &byteNode{output: []string{}}
+		}
+
+		node = node.children[character]
+	}
+
+	// Register the complete pattern at the terminal node.
+	node.patterns = append(node.patterns, string(pattern))
+
+	aho.built = false
+
+	return nil
+}
+
+// BuildFailureLinks constructs the failure links used during pattern search. Searches call it
+// automatically whenever patterns were added since the last build, so calling it explicitly
+// is optional.
+func (aho *ByteAhoCorasick) BuildFailureLinks() {
+	var queue []*byteNode = []*byteNode{}
+
+	// Reset the root output so a rebuild never accumulates stale patterns.
+	aho.root.output = append([]string{}, aho.root.patterns...)
+
+	// Set fail links of depth-1 children to root and enqueue them for BFS.
+	for _, child := range aho.root.children {
+		if child == nil {
+			continue
+		}
+
+		child.fail = aho.root
+		child.output = append([]string{}, child.patterns...)
+		queue = append(queue, child)
+	}
+
+	// BFS traversal to build failure links for all nodes.
+	for len(queue) > 0 {
+		var current *byteNode = queue[0]
+
+		queue = queue[1:]
+
+		for character, child := range current.children {
+			if child == nil {
+				continue
+			}
+
+			var fail *byteNode = current.fail
+
+			// Start from the node's own patterns before merging inherited output.
+			child.output = append([]string{}, child.patterns...)
+
+			// Start following failure links from the parent's fail node.
+			for fail != nil && fail.children[character] == nil {
+				fail = fail.fail
+			}
+
+			if fail == nil {
+				child.fail = aho.root
+			} else {
+				// Inherit the matched child and merge its output patterns.
+				child.fail = fail.children[character]
+				child.output = append(child.output, child.fail.output...)
+			}
+
+			queue = append(queue, child)
+		}
+	}
+
+	aho.built = true
+}
+
+// Search scans the given text for all patterns previously added to the trie.
+// Returns a map from matched pattern to list of starting byte offsets in the text, in the
+// same form as AhoCorasick.Search. Stale failure links are rebuilt before scanning.
+func (aho *ByteAhoCorasick) Search(text []byte) map[string][]int {
+	var result map[string][]int = make(map[string][]int)
+
+	if !aho.built {
+		aho.BuildFailureLinks()
+	}
+
+	var node *byteNode = aho.root
+
+	for index, character := range text {
+		// Follow failure links until a transition exists or the root is reached.
+		for node != aho.root && node.children[character] == nil {
+			node = node.fail
+		}
+
+		if next := node.children[character]; next != nil {
+			node = next
+		}
+
+		// Record all matched patterns with their starting offsets.
+		for _, pattern := range node.output {
+			result[pattern] = append(result[pattern], index+1-len(pattern))
+		}
+	}
+
+	return result
+}
@@ -9,10 +9,21 @@
 //
 //	The implementation supports a variety of common tree operations:
 //	- Creating nodes with string values
+//	- Attaching arbitrary metadata to nodes through a lazily created map
 //	- Adding children via value or existing node references
-//	- Removing child nodes
+//	- Removing child nodes and replacing them in place
 //	- Recursive search for node values
+//	- Descending along an exact path of child values
 //	- Printing the tree structure (top-down and bottom-up traversal)
+//	- Measuring the subtree diameter (longest path between any two nodes)
+//	- Collecting all nodes at a given depth, in left-to-right order
+//	- A SafeNode wrapper serializing mutations and allowing concurrent reads
+//	- A Tree wrapper firing OnAdd and OnRemove hooks on structural changes
+//	- Deep cloning of subtrees and merging of trees by matching node values
+//	- Conversion to and from nested slices for fixtures and interchange
+//	- A generic pre-order Fold for aggregating values over a subtree
+//	- Counting the nodes of a subtree that satisfy a predicate
+//	- Iterative pre-order and post-order walks with early exit
 //
 //	This structure is ideal for representing hierarchical relationships
 //	where bidirectional navigation is essential, such as organizational
@@ -32,14 +43,27 @@
 // ===================================================================================
 package bidirectionalimplementation
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrMalformedNested is returned by FromNested when the data does not describe a tree.
+var ErrMalformedNested = errors.New("bi_directional: malformed nested tree")
 
 // Node represents a node in a bi-directional tree.
 // Each node has a value, a pointer to its parent, and a slice of children.
+// Node performs no locking and is meant for single-threaded use; wrap the tree
+// in a SafeNode to share it between goroutines.
+//
+// Meta holds optional metadata about the node, such as a source URL or a confidence score. It
+// stays nil until the first call to SetMeta.
 type Node struct {
 	Value    string
 	Parent   *Node
 	Children []*Node
+	Meta     map[string]any
 }
 
 // AddChild creates a new child node with the given value, attaches it to the current node,
@@ -62,6 +86,22 @@ func (node *Node) AddChildNode(child *Node) {
 	node.Children = append(node.Children, child)
 }
 
+// SetMeta stores a metadata value under the key, creating the Meta map on first use.
+func (node *Node) SetMeta(key string, value any) {
+	if node.Meta == nil {
+		node.Meta = make(map[string]any)
+	}
+
+	node.Meta[key] = value
+}
+
+// GetMeta returns the metadata value stored under the key and whether it was present.
+func (node *Node) GetMeta(key string) (any, bool) {
+	value, exists := node.Meta[key]
+
+	return value, exists
+}
+
 // Find searches the tree recursively starting from the current node
 // for a node containing the specified value. Returns a pointer to the found node or nil if not found.
 func (node *Node) Find(value string) *Node {
@@ -78,6 +118,39 @@ func (node *Node) Find(value string) *Node {
 	return nil
 }
 
+// FindPath descends from the current node following the given sequence of child values and
+// returns the node reached by the full path, or nil if some step has no child with the next
+// value. At each step the first child with a matching value is taken. Unlike Find, this
+// distinguishes nodes that share a value in different branches. An empty path returns the
+// current node.
+//
+// Example:
+//
+//	root.FindPath("Desvenlafaxine", "Pharmacokinetics")
+func (node *Node) FindPath(values ...string) *Node {
+	var current *Node = node
+
+	for _, value := range values {
+		var next *Node = nil
+
+		for _, child := range current.Children {
+			if child.Value == value {
+				next = child
+
+				break
+			}
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		current = next
+	}
+
+	return current
+}
+
 // RemoveChild removes the specified child node from the current node's children slice.
 // It also sets the removed child’s parent pointer to nil. Returns true if the child was found and removed.
 func (node *Node) RemoveChild(child *Node) bool {
@@ -94,6 +167,30 @@ func (node *Node) RemoveChild(child *Node) bool {
 	return false
 }
 
+// ReplaceChild swaps oldChild for newChild at the same position in the current node's children
+// slice, so sibling order is preserved. It sets oldChild's parent pointer to nil and newChild's
+// parent pointer to the current node; replacing a child with itself leaves it in place. Returns
+// false, leaving the tree unchanged, if newChild is nil or oldChild is not a child of the current
+// node.
+func (node *Node) ReplaceChild(oldChild *Node, newChild *Node) bool {
+	if newChild == nil {
+		return false
+	}
+
+	for index, descendant := range node.Children {
+		if descendant == oldChild {
+			// Clear the old parent first so a self-replacement keeps its parent.
+			oldChild.Parent = nil
+			node.Children[index] = newChild
+			newChild.Parent = node
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // PrintDown prints the tree structure starting from the current node down to all descendants.
 // The level argument is used to control indentation for hierarchical display.
 func (node *Node) PrintDown(level int) {
@@ -127,3 +224,355 @@ func (node *Node) PrintUp() {
 
 	fmt.Println()
 }
+
+// Diameter returns the number of edges on the longest path between any two nodes in the
+// subtree rooted at the current node. The path need not pass through the current node.
+// A single node has a diameter of 0.
+func (node *Node) Diameter() int {
+	_, diameter := node.heightAndDiameter()
+
+	return diameter
+}
+
+// heightAndDiameter computes, in a single post-order pass, the height of the subtree in edges
+// and the longest path found anywhere within it.
+func (node *Node) heightAndDiameter() (int, int) {
+	var longestBranch int = 0
+	var secondLongestBranch int = 0
+	var diameter int = 0
+
+	for _, child := range node.Children {
+		childHeight, childDiameter := child.heightAndDiameter()
+
+		// The best path may lie entirely inside a child's subtree.
+		if childDiameter > diameter {
+			diameter = childDiameter
+		}
+
+		// Track the two deepest branches below this node, counting the edge to the child.
+		if branch := childHeight + 1; branch > longestBranch {
+			secondLongestBranch = longestBranch
+			longestBranch = branch
+		} else if branch > secondLongestBranch {
+			secondLongestBranch = branch
+		}
+	}
+
+	// The longest path through this node joins its two deepest branches.
+	if longestBranch+secondLongestBranch > diameter {
+		diameter = longestBranch + secondLongestBranch
+	}
+
+	return longestBranch, diameter
+}
+
+// NodesAtDepth returns all nodes exactly depth edges below the current node, in left-to-right
+// order. Depth 0 returns the current node itself; a negative depth or one beyond the deepest
+// leaf returns an empty slice.
+func (node *Node) NodesAtDepth(depth int) []*Node {
+	if depth < 0 {
+		return []*Node{}
+	}
+
+	var level []*Node = []*Node{node}
+
+	// Descend one level at a time, keeping children in order.
+	for currentDepth := 0; currentDepth < depth && len(level) > 0; currentDepth++ {
+		var nextLevel []*Node = []*Node{}
+
+		for _, current := range level {
+			nextLevel = append(nextLevel, current.Children...)
+		}
+
+		level = nextLevel
+	}
+
+	return level
+}
+
+// Clone returns a deep copy of the subtree rooted at the current node. The copy's root has no
+// parent, and every copied child points to its copied parent. Each node's Meta map is copied,
+// so metadata set on the clone does not affect the original; the values themselves are copied
+// by assignment, so a pointer value is still shared.
+func (node *Node) Clone() *Node {
+	var copied *Node = &Node{Value: node.Value}
+
+	for key, value := range node.Meta {
+		copied.SetMeta(key, value)
+	}
+
+	for _, child := range node.Children {
+		copied.AddChildNode(child.Clone())
+	}
+
+	return copied
+}
+
+// Merge overlays the other tree onto the current node. The two roots are taken to correspond;
+// their values are not compared. For each child of other, in order:
+//   - if the current node has a child with the same Value (the first one, when several do),
+//     the two are treated as the same node and Merge recurses into it;
+//   - otherwise a deep clone of the child is attached at the end of the children.
+//
+// A value collision is therefore never duplicated: colliding leaves collapse into the existing
+// node, and colliding inner nodes gain the union of both children. Children with equal values
+// under other all merge into the same node. The other tree is never modified or shared.
+func (node *Node) Merge(other *Node) {
+	for _, otherChild := range other.Children {
+		var matched *Node = nil
+
+		for _, child := range node.Children {
+			if child.Value == otherChild.Value {
+				matched = child
+
+				break
+			}
+		}
+
+		if matched != nil {
+			matched.Merge(otherChild)
+		} else {
+			node.AddChildNode(otherChild.Clone())
+		}
+	}
+}
+
+// ToNested returns a recursive representation of the subtree rooted at the current node, in
+// which each node is a slice holding its value followed by one nested slice per child.
+//
+// Example:
+//
+//	root.ToNested() // []any{"Root", []any{"Child", []any{"Grandchild"}}}
+func (node *Node) ToNested() []any {
+	var nested []any = []any{node.Value}
+
+	for _, child := range node.Children {
+		nested = append(nested, child.ToNested())
+	}
+
+	return nested
+}
+
+// FromNested rebuilds a tree from the representation produced by ToNested, setting every
+// parent pointer. Returns an error wrapping ErrMalformedNested if a node is empty, its first
+// element is not a string, or any later element is not a nested []any.
+func FromNested(data []any) (*Node, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty node", ErrMalformedNested)
+	}
+
+	value, isString := data[0].(string)
+
+	if !isString {
+		return nil, fmt.Errorf("%w: node value %v is a %T, not a string", ErrMalformedNested, data[0], data[0])
+	}
+
+	var node *Node = &Node{Value: value}
+
+	for index, element := range data[1:] {
+		nested, isSlice := element.([]any)
+
+		if !isSlice {
+			return nil, fmt.Errorf("%w: child %d of %q is a %T, not a []any", ErrMalformedNested, index, value, element)
+		}
+
+		child, err := FromNested(nested)
+
+		if err != nil {
+			return nil, err
+		}
+
+		node.AddChildNode(child)
+	}
+
+	return node, nil
+}
+
+// Fold visits every node of the subtree rooted at node in pre-order (a node before its
+// children, children left to right), threading an accumulator through combine, and returns
+// the final accumulated value. Go methods cannot declare type parameters, so Fold is a
+// function rather than a method on Node.
+//
+// Example:
+//
+//	var count int = Fold(root, 0, func(count int, node *Node) int { return count + 1 })
+func Fold[R any](node *Node, init R, combine func(acc R, n *Node) R) R {
+	var accumulator R = combine(init, node)
+
+	for _, child := range node.Children {
+		accumulator = Fold(child, accumulator, combine)
+	}
+
+	return accumulator
+}
+
+// CountFunc returns how many nodes in the subtree rooted at the current node, including the
+// node itself, satisfy the predicate. No intermediate slice of nodes is built.
+//
+// Example:
+//
+//	var dizzy int = root.CountFunc(func(node *Node) bool {
+//		return len(node.Children) == 0 && strings.Contains(node.Value, "dizziness")
+//	})
+func (node *Node) CountFunc(pred func(*Node) bool) int {
+	return Fold(node, 0, func(count int, current *Node) int {
+		if pred(current) {
+			return count + 1
+		}
+
+		return count
+	})
+}
+
+// WalkPreOrder visits the subtree rooted at the current node in pre-order (a node before its
+// children, children left to right), the same order as Fold. The walk uses an explicit stack
+// rather than recursion, so arbitrarily deep trees cannot overflow the goroutine stack. If visit
+// returns false, the walk stops immediately and no further nodes are visited.
+func (node *Node) WalkPreOrder(visit func(*Node) bool) {
+	var stack []*Node = []*Node{node}
+
+	for len(stack) > 0 {
+		var current *Node = stack[len(stack)-1]
+
+		stack = stack[:len(stack)-1]
+
+		if !visit(current) {
+			return
+		}
+
+		// Push the children in reverse so the leftmost child is visited first.
+		for index := len(current.Children) - 1; index >= 0; index-- {
+			stack = append(stack, current.Children[index])
+		}
+	}
+}
+
+// walkFrame records a node on the WalkPostOrder stack and the index of its next child to descend into.
+type walkFrame struct {
+	node  *Node
+	child int
+}
+
+// WalkPostOrder visits the subtree rooted at the current node in post-order (children left to
+// right, then the node itself), using an explicit stack like WalkPreOrder. If visit returns
+// false, the walk stops immediately and no further nodes are visited.
+func (node *Node) WalkPostOrder(visit func(*Node) bool) {
+	var stack []walkFrame = []walkFrame{{node: node}}
+
+	for len(stack) > 0 {
+		var top *walkFrame = &stack[len(stack)-1]
+
+		// Descend into the next unvisited child, if any.
+		if top.child < len(top.node.Children) {
+			var child *Node = top.node.Children[top.child]
+
+			top.child++
+			stack = append(stack, walkFrame{node: child})
+
+			continue
+		}
+
+		// All children are done, so the node itself comes next.
+		stack = stack[:len(stack)-1]
+
+		if !visit(top.node) {
+			return
+		}
+	}
+}
+
+// Tree wraps the root of a tree and notifies optional hooks of structural changes made through
+// its methods, for example to keep a user interface in sync. OnAdd is called after a child has
+// been attached and OnRemove after a child has been detached, each exactly once per successful
+// operation; nil hooks are skipped. Changes made directly through Node methods are not reported.
+type Tree struct {
+	Root     *Node
+	OnAdd    func(parent *Node, child *Node)
+	OnRemove func(parent *Node, child *Node)
+}
+
+// NewTree wraps the tree rooted at root. Hooks can be set on the returned Tree.
+func NewTree(root *Node) *Tree {
+	return &Tree{Root: root}
+}
+
+// AddChild creates a child with the given value under parent, a node of the tree, fires
+// OnAdd, and returns the new child.
+func (tree *Tree) AddChild(parent *Node, value string) *Node {
+	var child *Node = parent.AddChild(value)
+
+	if tree.OnAdd != nil {
+		tree.OnAdd(parent, child)
+	}
+
+	return child
+}
+
+// AddChildNode attaches an existing node as a child of parent, a node of the tree, and fires OnAdd.
+func (tree *Tree) AddChildNode(parent *Node, child *Node) {
+	parent.AddChildNode(child)
+
+	if tree.OnAdd != nil {
+		tree.OnAdd(parent, child)
+	}
+}
+
+// RemoveChild removes child from parent's children and fires OnRemove if it was removed.
+// Returns true if the child was found and removed.
+func (tree *Tree) RemoveChild(parent *Node, child *Node) bool {
+	if !parent.RemoveChild(child) {
+		return false
+	}
+
+	if tree.OnRemove != nil {
+		tree.OnRemove(parent, child)
+	}
+
+	return true
+}
+
+// SafeNode guards a whole tree with a read/write mutex so it can be shared between goroutines.
+// Mutations through AddChildSafe and RemoveChildSafe are serialized, while FindSafe calls may
+// run concurrently with each other. The tree must only be accessed through the SafeNode while
+// it is shared; the unguarded Node methods remain for single-threaded use.
+type SafeNode struct {
+	mutex sync.RWMutex
+	root  *Node
+}
+
+// NewSafeNode wraps the tree rooted at root for concurrent use.
+func NewSafeNode(root *Node) *SafeNode {
+	return &SafeNode{root: root}
+}
+
+// Root returns the wrapped root node. Reading or changing the tree through it bypasses the lock.
+func (safe *SafeNode) Root() *Node {
+	return safe.root
+}
+
+// AddChildSafe creates a child with the given value under parent, a node of the wrapped tree,
+// while holding the write lock, and returns the new child.
+func (safe *SafeNode) AddChildSafe(parent *Node, value string) *Node {
+	safe.mutex.Lock()
+	defer safe.mutex.Unlock()
+
+	return parent.AddChild(value)
+}
+
+// RemoveChildSafe removes child from parent's children while holding the write lock.
+// Returns true if the child was found and removed.
+func (safe *SafeNode) RemoveChildSafe(parent *Node, child *Node) bool {
+	safe.mutex.Lock()
+	defer safe.mutex.Unlock()
+
+	return parent.RemoveChild(child)
+}
+
+// FindSafe searches the wrapped tree for a node with the specified value while holding the read
+// lock, so several searches can run at once. Returns the found node or nil if not found.
+func (safe *SafeNode) FindSafe(value string) *Node {
+	safe.mutex.RLock()
+	defer safe.mutex.RUnlock()
+
+	return safe.root.Find(value)
+}
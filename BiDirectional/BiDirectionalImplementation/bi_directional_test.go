@@ -10,9 +10,20 @@
 //	core tree operations, including:
 //
 //	- Relationship maintenance (adding children by value and node reference)
-//	- Node search (finding existing and non-existing nodes)
+//	- Node search (finding existing and non-existing nodes, and exact value paths)
 //	- Removing children (valid removals and attempts to remove non-children)
+//	- Replacing children in place (sibling order, parent pointers, self and nil replacements)
 //	- Traversal output (validating PrintUp hierarchical path printing)
+//	- Subtree diameter (balanced, skewed and off-root longest paths)
+//	- Level queries (nodes at a given depth, in left-to-right order)
+//	- Concurrent access through SafeNode (run with -race)
+//	- Tree hooks firing on additions and removals
+//	- Cloning subtrees and merging overlapping trees
+//	- Nested slice round-trips and malformed nested input
+//	- Folding over a subtree (counting nodes, concatenating leaves, visit order)
+//	- Counting nodes matching a predicate (all, none and a subset)
+//	- Iterative pre-order and post-order walks (visit order, early exit, deep chains)
+//	- Node metadata (lazy creation, lookups, and copying on clone)
 //
 //	All tests are written using Go’s built-in "testing" package.
 //
@@ -25,21 +36,61 @@
 //	✅ TestAddChildNodeMaintainsRelationship
 //	✅ TestFindNodeExists
 //	✅ TestFindNodeNotExists
+//	✅ TestFindPathComplete
+//	✅ TestFindPathBroken
+//	✅ TestFindPathEmpty
 //	✅ TestRemoveChildValid
 //	✅ TestRemoveChildInvalid
+//	✅ TestReplaceChildPreservesPosition
+//	✅ TestReplaceChildInvalid
+//	✅ TestReplaceChildSelf
+//	✅ TestReplaceChildNil
 //	✅ TestPrintUpDisplaysCorrectPath
+//	✅ TestDiameterSingleNode
+//	✅ TestDiameterBalancedTree
+//	✅ TestDiameterSkewedTree
+//	✅ TestDiameterNotThroughRoot
+//	✅ TestNodesAtDepth
+//	✅ TestNodesAtDepthOutOfRange
+//	✅ TestSafeNodeConcurrentAdds
+//	✅ TestSafeNodeConcurrentAddsRemovesAndFinds
+//	✅ TestTreeHooksFireOncePerOperation
+//	✅ TestTreeNilHooksAreSkipped
+//	✅ TestCloneIsIndependent
+//	✅ TestMergeOverlappingTrees
+//	✅ TestNestedRoundTrip
+//	✅ TestFromNestedMalformed
+//	✅ TestFoldCountsNodes
+//	✅ TestFoldConcatenatesLeafValues
+//	✅ TestFoldVisitsInPreOrder
+//	✅ TestCountFuncMatchesAll
+//	✅ TestCountFuncMatchesNone
+//	✅ TestCountFuncMatchesSubset
+//	✅ TestWalkVisitOrder
+//	✅ TestWalkStopsEarly
+//	✅ TestWalkDeepTree
+//	✅ TestMetaSetAndGet
+//	✅ TestCloneCopiesMeta
 //
 // Usage:
 //
 //	To run all tests:
 //	$ go test -v
 //
+//	To check the concurrent tests for data races:
+//	$ go test -race
+//
 // ===================================================================================
 package bidirectionalimplementation
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -124,6 +175,73 @@ func TestFindNodeNotExists(test *testing.T) {
 	}
 }
 
+// buildPharmaceuticalTree builds two drugs that both have a "Pharmacokinetics" child, so the
+// value alone does not identify a single node:
+//
+//	Pharmaceutical
+//	├── Desvenlafaxine
+//	│   └── Pharmacokinetics
+//	│       └── Absorption
+//	└── Venlafaxine
+//	    └── Pharmacokinetics
+func buildPharmaceuticalTree() *Node {
+	var root *Node = &Node{Value: "Pharmaceutical"}
+
+	root.AddChild("Desvenlafaxine").AddChild("Pharmacokinetics").AddChild("Absorption")
+	root.AddChild("Venlafaxine").AddChild("Pharmacokinetics")
+
+	return root
+}
+
+// TestFindPathComplete verifies that a full path selects the node in the requested branch,
+// even though Find would return the first node with the same value.
+func TestFindPathComplete(test *testing.T) {
+	// Arrange.
+	var root *Node = buildPharmaceuticalTree()
+	var expected *Node = root.Children[1].Children[0]
+
+	// Act.
+	var found *Node = root.FindPath("Venlafaxine", "Pharmacokinetics")
+
+	// Assert.
+	if found != expected {
+		test.Errorf("Expected the Venlafaxine pharmacokinetics node, got %v.", found)
+	}
+
+	if found == root.Find("Pharmacokinetics") {
+		test.Error("Expected FindPath to differ from Find for a value shared across branches.")
+	}
+}
+
+// TestFindPathBroken verifies that a path with a missing step returns nil.
+func TestFindPathBroken(test *testing.T) {
+	// Arrange.
+	var root *Node = buildPharmaceuticalTree()
+
+	// Act.
+	var missingStep *Node = root.FindPath("Desvenlafaxine", "Pharmacodynamics")
+	var wrongBranch *Node = root.FindPath("Venlafaxine", "Pharmacokinetics", "Absorption")
+
+	// Assert.
+	if missingStep != nil || wrongBranch != nil {
+		test.Errorf("Expected nil for broken paths, got %v and %v.", missingStep, wrongBranch)
+	}
+}
+
+// TestFindPathEmpty verifies that an empty path returns the receiver.
+func TestFindPathEmpty(test *testing.T) {
+	// Arrange.
+	var root *Node = buildPharmaceuticalTree()
+
+	// Act.
+	var found *Node = root.FindPath()
+
+	// Assert.
+	if found != root {
+		test.Errorf("Expected the receiver for an empty path, got %v.", found)
+	}
+}
+
 // ==============
 // Remove Testing
 // ==============
@@ -168,6 +286,103 @@ func TestRemoveChildInvalid(test *testing.T) {
 	}
 }
 
+// TestReplaceChildPreservesPosition verifies that the replacement takes the old child's
+// position among its siblings and that both parent pointers are updated.
+func TestReplaceChildPreservesPosition(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+	var old *Node = root.Children[0]
+	var replacement *Node = &Node{Value: "C"}
+
+	// Act.
+	var replaced bool = root.ReplaceChild(old, replacement)
+
+	// Assert.
+	if !replaced {
+		test.Error("Expected child to be replaced.")
+	}
+
+	var expected []string = []string{"C", "B"}
+
+	if !reflect.DeepEqual(nodeValues(root.Children), expected) {
+		test.Errorf("Children = %v; want %v.", nodeValues(root.Children), expected)
+	}
+
+	if replacement.Parent != root {
+		test.Error("Expected replacement's parent to be root.")
+	}
+
+	if old.Parent != nil {
+		test.Error("Expected replaced child's parent to be nil.")
+	}
+}
+
+// TestReplaceChildInvalid verifies that replacing a node that is not a child returns false
+// and leaves the children and both parent pointers untouched.
+func TestReplaceChildInvalid(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+	var stranger *Node = root.Children[0].Children[0]
+	var replacement *Node = &Node{Value: "C"}
+
+	// Act.
+	var replaced bool = root.ReplaceChild(stranger, replacement)
+
+	// Assert.
+	if replaced {
+		test.Error("Expected false when replacing node not in children.")
+	}
+
+	var expected []string = []string{"A", "B"}
+
+	if !reflect.DeepEqual(nodeValues(root.Children), expected) {
+		test.Errorf("Children = %v; want %v.", nodeValues(root.Children), expected)
+	}
+
+	if stranger.Parent != root.Children[0] || replacement.Parent != nil {
+		test.Error("Expected parent pointers to be unchanged.")
+	}
+}
+
+// TestReplaceChildSelf verifies that replacing a child with itself succeeds and keeps it in
+// place with its parent pointer intact.
+func TestReplaceChildSelf(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+	var child *Node = root.Children[0]
+
+	// Act.
+	var replaced bool = root.ReplaceChild(child, child)
+
+	// Assert.
+	if !replaced {
+		test.Error("Expected self-replacement to succeed.")
+	}
+
+	if root.Children[0] != child || child.Parent != root {
+		test.Error("Expected child to stay in place with root as its parent.")
+	}
+}
+
+// TestReplaceChildNil verifies that a nil replacement is rejected and the tree is unchanged.
+func TestReplaceChildNil(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+	var child *Node = root.Children[0]
+
+	// Act.
+	var replaced bool = root.ReplaceChild(child, nil)
+
+	// Assert.
+	if replaced {
+		test.Error("Expected false when replacing with nil.")
+	}
+
+	if root.Children[0] != child || child.Parent != root {
+		test.Error("Expected child to stay in place with root as its parent.")
+	}
+}
+
 // =================
 // Traversal Testing
 // =================
@@ -219,3 +434,819 @@ func TestPrintUpDisplaysCorrectPath(test *testing.T) {
 		test.Errorf("Expected PrintUp output %q, got %q.", expected, output)
 	}
 }
+
+// ================
+// Diameter Testing
+// ================
+
+// buildBalancedTree builds a complete tree of the given depth where every internal node has
+// branching children, naming nodes by their path from the root.
+func buildBalancedTree(value string, depth int, branching int) *Node {
+	var node *Node = &Node{Value: value}
+
+	if depth == 0 {
+		return node
+	}
+
+	for index := 0; index < branching; index++ {
+		node.AddChildNode(buildBalancedTree(fmt.Sprintf("%s.%d", value, index), depth-1, branching))
+	}
+
+	return node
+}
+
+// TestDiameterSingleNode verifies that a lone node has a diameter of 0.
+func TestDiameterSingleNode(test *testing.T) {
+	// Arrange.
+	var root *Node = &Node{Value: "Root"}
+
+	// Act.
+	var diameter int = root.Diameter()
+
+	// Assert.
+	if diameter != 0 {
+		test.Errorf("Expected diameter 0, got %d.", diameter)
+	}
+}
+
+// TestDiameterBalancedTree verifies that the diameter of a balanced tree joins two leaves
+// through the root, and that each subtree reports its own diameter.
+func TestDiameterBalancedTree(test *testing.T) {
+	// Arrange.
+	var root *Node = buildBalancedTree("Root", 3, 2)
+
+	// Act.
+	var diameter int = root.Diameter()
+	var subtreeDiameter int = root.Children[0].Diameter()
+
+	// Assert.
+	if diameter != 6 {
+		test.Errorf("Expected diameter 6, got %d.", diameter)
+	}
+
+	if subtreeDiameter != 4 {
+		test.Errorf("Expected subtree diameter 4, got %d.", subtreeDiameter)
+	}
+}
+
+// TestDiameterSkewedTree verifies that a deep chain has a diameter equal to its length.
+func TestDiameterSkewedTree(test *testing.T) {
+	// Arrange.
+	var root *Node = &Node{Value: "Root"}
+	var current *Node = root
+
+	for depth := 1; depth <= 1000; depth++ {
+		current = current.AddChild(fmt.Sprintf("Node %d", depth))
+	}
+
+	// Act.
+	var diameter int = root.Diameter()
+
+	// Assert.
+	if diameter != 1000 {
+		test.Errorf("Expected diameter 1000, got %d.", diameter)
+	}
+}
+
+// TestDiameterNotThroughRoot verifies that the longest path is found even when it lies
+// entirely below the root.
+func TestDiameterNotThroughRoot(test *testing.T) {
+	// Arrange.
+	var root *Node = &Node{Value: "Root"}
+	var hub *Node = root.AddChild("Hub")
+
+	root.AddChild("Leaf")
+
+	for _, name := range []string{"Left", "Right"} {
+		var current *Node = hub
+
+		for depth := 1; depth <= 3; depth++ {
+			current = current.AddChild(fmt.Sprintf("%s %d", name, depth))
+		}
+	}
+
+	// Act.
+	var diameter int = root.Diameter()
+
+	// Assert.
+	if diameter != 6 {
+		test.Errorf("Expected diameter 6 between the two deep branches, got %d.", diameter)
+	}
+}
+
+// =============
+// Level Testing
+// =============
+
+// buildExampleTree builds a small two-level tree:
+//
+//	Root
+//	├── A
+//	│   ├── A1
+//	│   └── A2
+//	└── B
+//	    └── B1
+func buildExampleTree() *Node {
+	var root *Node = &Node{Value: "Root"}
+
+	var first *Node = root.AddChild("A")
+	var second *Node = root.AddChild("B")
+
+	first.AddChild("A1")
+	first.AddChild("A2")
+	second.AddChild("B1")
+
+	return root
+}
+
+// nodeValues returns the values of the nodes, in order.
+func nodeValues(nodes []*Node) []string {
+	var values []string = []string{}
+
+	for _, node := range nodes {
+		values = append(values, node.Value)
+	}
+
+	return values
+}
+
+// TestNodesAtDepth verifies the node sets at depths 0, 1 and 2 of the example tree.
+func TestNodesAtDepth(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	var expected [][]string = [][]string{
+		{"Root"},
+		{"A", "B"},
+		{"A1", "A2", "B1"},
+	}
+
+	for depth, values := range expected {
+		// Act.
+		var nodes []*Node = root.NodesAtDepth(depth)
+
+		// Assert.
+		if !reflect.DeepEqual(nodeValues(nodes), values) {
+			test.Errorf("NodesAtDepth(%d) = %v; want %v.", depth, nodeValues(nodes), values)
+		}
+	}
+
+	if nodes := root.NodesAtDepth(0); len(nodes) != 1 || nodes[0] != root {
+		test.Error("Expected depth 0 to return the receiver itself.")
+	}
+}
+
+// TestNodesAtDepthOutOfRange verifies that depths beyond the tree, or negative depths,
+// return an empty slice.
+func TestNodesAtDepthOutOfRange(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	for _, depth := range []int{3, 10, -1} {
+		// Act.
+		var nodes []*Node = root.NodesAtDepth(depth)
+
+		// Assert.
+		if nodes == nil || len(nodes) != 0 {
+			test.Errorf("NodesAtDepth(%d) = %v; want an empty slice.", depth, nodeValues(nodes))
+		}
+	}
+}
+
+// ===================
+// Concurrency Testing
+// ===================
+
+// TestSafeNodeConcurrentAdds verifies that children added from many goroutines are all kept.
+func TestSafeNodeConcurrentAdds(test *testing.T) {
+	const GOROUTINES int = 16
+	const ADDS_PER_GOROUTINE int = 100
+
+	// Arrange.
+	var root *Node = &Node{Value: "Root"}
+	var safe *SafeNode = NewSafeNode(root)
+
+	var waitGroup sync.WaitGroup
+
+	// Act.
+	for worker := 0; worker < GOROUTINES; worker++ {
+		waitGroup.Add(1)
+
+		go func(worker int) {
+			defer waitGroup.Done()
+
+			for index := 0; index < ADDS_PER_GOROUTINE; index++ {
+				safe.AddChildSafe(root, fmt.Sprintf("Child %d-%d", worker, index))
+			}
+		}(worker)
+	}
+
+	waitGroup.Wait()
+
+	// Assert.
+	if len(root.Children) != GOROUTINES*ADDS_PER_GOROUTINE {
+		test.Errorf("Expected %d children, got %d.", GOROUTINES*ADDS_PER_GOROUTINE, len(root.Children))
+	}
+
+	for _, child := range root.Children {
+		if child.Parent != root {
+			test.Fatalf("Expected every child's parent to be root, got %v.", child.Parent)
+		}
+	}
+}
+
+// TestSafeNodeConcurrentAddsRemovesAndFinds mixes writers and readers and checks the final shape.
+func TestSafeNodeConcurrentAddsRemovesAndFinds(test *testing.T) {
+	const GOROUTINES int = 8
+
+	// Arrange.
+	var root *Node = &Node{Value: "Root"}
+	var safe *SafeNode = NewSafeNode(root)
+
+	var waitGroup sync.WaitGroup
+
+	// Act.
+	for worker := 0; worker < GOROUTINES; worker++ {
+		waitGroup.Add(2)
+
+		// Writer: add two children and remove one of them.
+		go func(worker int) {
+			defer waitGroup.Done()
+
+			var kept *Node = safe.AddChildSafe(root, fmt.Sprintf("Kept %d", worker))
+			var removed *Node = safe.AddChildSafe(root, fmt.Sprintf("Removed %d", worker))
+
+			safe.AddChildSafe(kept, fmt.Sprintf("Grandchild %d", worker))
+
+			if !safe.RemoveChildSafe(root, removed) {
+				test.Errorf("Expected to remove %q.", removed.Value)
+			}
+		}(worker)
+
+		// Reader: search while the tree changes.
+		go func(worker int) {
+			defer waitGroup.Done()
+
+			for attempt := 0; attempt < 50; attempt++ {
+				safe.FindSafe(fmt.Sprintf("Grandchild %d", worker))
+			}
+		}(worker)
+	}
+
+	waitGroup.Wait()
+
+	// Assert.
+	if len(root.Children) != GOROUTINES {
+		test.Errorf("Expected %d children, got %d.", GOROUTINES, len(root.Children))
+	}
+
+	for worker := 0; worker < GOROUTINES; worker++ {
+		if safe.FindSafe(fmt.Sprintf("Grandchild %d", worker)) == nil {
+			test.Errorf("Expected to find grandchild %d.", worker)
+		}
+
+		if safe.FindSafe(fmt.Sprintf("Removed %d", worker)) != nil {
+			test.Errorf("Expected removed child %d to be gone.", worker)
+		}
+	}
+}
+
+// ============
+// Hook Testing
+// ============
+
+// hookEvent records one hook invocation.
+type hookEvent struct {
+	kind   string
+	parent *Node
+	child  *Node
+}
+
+// TestTreeHooksFireOncePerOperation verifies that each successful addition and removal fires
+// the matching hook exactly once with the correct nodes, and that a failed removal fires nothing.
+func TestTreeHooksFireOncePerOperation(test *testing.T) {
+	// Arrange.
+	var tree *Tree = NewTree(&Node{Value: "Root"})
+	var events []hookEvent = []hookEvent{}
+
+	tree.OnAdd = func(parent *Node, child *Node) {
+		events = append(events, hookEvent{kind: "add", parent: parent, child: child})
+	}
+
+	tree.OnRemove = func(parent *Node, child *Node) {
+		events = append(events, hookEvent{kind: "remove", parent: parent, child: child})
+	}
+
+	var existing *Node = &Node{Value: "Existing"}
+
+	// Act.
+	var child *Node = tree.AddChild(tree.Root, "Child")
+
+	tree.AddChildNode(child, existing)
+
+	var removed bool = tree.RemoveChild(child, existing)
+	var removedAgain bool = tree.RemoveChild(child, existing)
+
+	// Assert.
+	var expected []hookEvent = []hookEvent{
+		{kind: "add", parent: tree.Root, child: child},
+		{kind: "add", parent: child, child: existing},
+		{kind: "remove", parent: child, child: existing},
+	}
+
+	if !removed || removedAgain {
+		test.Errorf("Expected the first removal to succeed and the second to fail, got %v and %v.", removed, removedAgain)
+	}
+
+	if !reflect.DeepEqual(events, expected) {
+		test.Errorf("Hook events = %v; want %v.", events, expected)
+	}
+}
+
+// TestTreeNilHooksAreSkipped verifies that a Tree without hooks still changes the structure.
+func TestTreeNilHooksAreSkipped(test *testing.T) {
+	// Arrange.
+	var tree *Tree = NewTree(&Node{Value: "Root"})
+
+	// Act.
+	var child *Node = tree.AddChild(tree.Root, "A")
+
+	tree.AddChildNode(tree.Root, &Node{Value: "B"})
+
+	var removed bool = tree.RemoveChild(tree.Root, child)
+
+	// Assert.
+	if !removed || render(tree.Root) != "Root(B)" {
+		test.Errorf("Expected Root(B) after removing A, got %s.", render(tree.Root))
+	}
+}
+
+// =============
+// Merge Testing
+// =============
+
+// render describes a subtree as Value(child, child, ...), checking parent pointers on the way.
+func render(node *Node) string {
+	if len(node.Children) == 0 {
+		return node.Value
+	}
+
+	var children []string = []string{}
+
+	for _, child := range node.Children {
+		if child.Parent != node {
+			return "broken parent link at " + child.Value
+		}
+
+		children = append(children, render(child))
+	}
+
+	return node.Value + "(" + strings.Join(children, ", ") + ")"
+}
+
+// TestCloneIsIndependent verifies that a clone has the same structure and shares no nodes.
+func TestCloneIsIndependent(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	// Act.
+	var clone *Node = root.Clone()
+
+	clone.Children[0].AddChild("A3")
+
+	// Assert.
+	if clone.Parent != nil {
+		test.Error("Expected the clone's root to have no parent.")
+	}
+
+	if render(root) != "Root(A(A1, A2), B(B1))" {
+		test.Errorf("Expected the original to be unchanged, got %s.", render(root))
+	}
+
+	if render(clone) != "Root(A(A1, A2, A3), B(B1))" {
+		test.Errorf("Unexpected clone structure %s.", render(clone))
+	}
+}
+
+// TestMergeOverlappingTrees merges two overlapping three-level drug records and checks the
+// unified structure, including collisions on inner nodes and leaves.
+func TestMergeOverlappingTrees(test *testing.T) {
+	// Arrange.
+	var record *Node = &Node{Value: "Desvenlafaxine"}
+
+	var pharmacokinetics *Node = record.AddChild("Pharmacokinetics")
+
+	pharmacokinetics.AddChild("Absorption")
+	pharmacokinetics.AddChild("Metabolism")
+	record.AddChild("Indications").AddChild("MDD")
+
+	var partial *Node = &Node{Value: "Desvenlafaxine"}
+
+	var otherPharmacokinetics *Node = partial.AddChild("Pharmacokinetics")
+
+	otherPharmacokinetics.AddChild("Metabolism")
+	otherPharmacokinetics.AddChild("Elimination")
+	partial.AddChild("Contraindications").AddChild("MAOIs")
+
+	var expected string = "Desvenlafaxine(Pharmacokinetics(Absorption, Metabolism, Elimination), " +
+		"Indications(MDD), Contraindications(MAOIs))"
+
+	// Act.
+	record.Merge(partial)
+
+	// Assert.
+	if render(record) != expected {
+		test.Errorf("Merged tree = %s; want %s.", render(record), expected)
+	}
+
+	if render(partial) != "Desvenlafaxine(Pharmacokinetics(Metabolism, Elimination), Contraindications(MAOIs))" {
+		test.Errorf("Expected the merged-in tree to be unchanged, got %s.", render(partial))
+	}
+
+	if record.Find("MAOIs") == partial.Find("MAOIs") {
+		test.Error("Expected merged nodes to be clones, not shared with the other tree.")
+	}
+}
+
+// ==============
+// Nested Testing
+// ==============
+
+// TestNestedRoundTrip converts the example tree to nested slices and back, checking the exact
+// nested form and the rebuilt structure, including parent pointers.
+func TestNestedRoundTrip(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	var expected []any = []any{"Root",
+		[]any{"A", []any{"A1"}, []any{"A2"}},
+		[]any{"B", []any{"B1"}},
+	}
+
+	// Act.
+	var nested []any = root.ToNested()
+
+	rebuilt, err := FromNested(nested)
+
+	// Assert.
+	if !reflect.DeepEqual(nested, expected) {
+		test.Errorf("ToNested() = %v; want %v.", nested, expected)
+	}
+
+	if err != nil {
+		test.Fatalf("Unexpected error %v.", err)
+	}
+
+	if rebuilt.Parent != nil || render(rebuilt) != render(root) {
+		test.Errorf("Rebuilt tree = %s; want %s.", render(rebuilt), render(root))
+	}
+}
+
+// TestFromNestedMalformed verifies that malformed nested input is rejected with ErrMalformedNested.
+func TestFromNestedMalformed(test *testing.T) {
+	// Arrange.
+	var inputs [][]any = [][]any{
+		{},
+		{42},
+		{"Root", "A"},
+		{"Root", []any{"A", []any{}}},
+		{"Root", []string{"A"}},
+	}
+
+	for _, input := range inputs {
+		// Act.
+		node, err := FromNested(input)
+
+		// Assert.
+		if node != nil || !errors.Is(err, ErrMalformedNested) {
+			test.Errorf("FromNested(%v) = %v, %v; want nil, ErrMalformedNested.", input, node, err)
+		}
+	}
+}
+
+// ============
+// Fold Testing
+// ============
+
+// TestFoldCountsNodes folds the example tree and one of its subtrees into node counts.
+func TestFoldCountsNodes(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	var countNodes = func(count int, node *Node) int {
+		return count + 1
+	}
+
+	// Act.
+	var total int = Fold(root, 0, countNodes)
+	var subtree int = Fold(root.Children[0], 0, countNodes)
+
+	// Assert.
+	if total != 6 {
+		test.Errorf("Expected 6 nodes, got %d.", total)
+	}
+
+	if subtree != 3 {
+		test.Errorf("Expected 3 nodes in subtree A, got %d.", subtree)
+	}
+}
+
+// TestFoldConcatenatesLeafValues folds the example tree into a summary of its leaf values.
+func TestFoldConcatenatesLeafValues(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	// Act.
+	var summary string = Fold(root, "", func(summary string, node *Node) string {
+		if len(node.Children) > 0 {
+			return summary
+		}
+
+		if summary == "" {
+			return node.Value
+		}
+
+		return summary + ", " + node.Value
+	})
+
+	// Assert.
+	if summary != "A1, A2, B1" {
+		test.Errorf("Expected leaf summary %q, got %q.", "A1, A2, B1", summary)
+	}
+}
+
+// TestFoldVisitsInPreOrder verifies that each node is visited before its children.
+func TestFoldVisitsInPreOrder(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	// Act.
+	var order []string = Fold(root, []string{}, func(order []string, node *Node) []string {
+		return append(order, node.Value)
+	})
+
+	// Assert.
+	var expected []string = []string{"Root", "A", "A1", "A2", "B", "B1"}
+
+	if !reflect.DeepEqual(order, expected) {
+		test.Errorf("Fold visit order = %v; want %v.", order, expected)
+	}
+}
+
+// =============
+// Count Testing
+// =============
+
+// TestCountFuncMatchesAll verifies that a predicate accepting every node counts the whole subtree.
+func TestCountFuncMatchesAll(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	// Act.
+	var count int = root.CountFunc(func(node *Node) bool {
+		return true
+	})
+
+	// Assert.
+	if count != 6 {
+		test.Errorf("Expected 6 matching nodes, got %d.", count)
+	}
+}
+
+// TestCountFuncMatchesNone verifies that a predicate rejecting every node counts zero.
+func TestCountFuncMatchesNone(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	// Act.
+	var count int = root.CountFunc(func(node *Node) bool {
+		return false
+	})
+
+	// Assert.
+	if count != 0 {
+		test.Errorf("Expected 0 matching nodes, got %d.", count)
+	}
+}
+
+// TestCountFuncMatchesSubset counts the leaves whose value mentions a keyword, both from the
+// root and from a subtree.
+func TestCountFuncMatchesSubset(test *testing.T) {
+	// Arrange.
+	var root *Node = &Node{Value: "Adverse Effects"}
+	var common *Node = root.AddChild("Common")
+	var rare *Node = root.AddChild("Rare")
+
+	common.AddChild("Nausea")
+	common.AddChild("Mild dizziness")
+	rare.AddChild("Severe dizziness")
+	rare.AddChild("Seizures")
+
+	var mentionsDizziness = func(node *Node) bool {
+		return len(node.Children) == 0 && strings.Contains(strings.ToLower(node.Value), "dizziness")
+	}
+
+	// Act.
+	var total int = root.CountFunc(mentionsDizziness)
+	var rareOnly int = rare.CountFunc(mentionsDizziness)
+
+	// Assert.
+	if total != 2 {
+		test.Errorf("Expected 2 dizziness leaves, got %d.", total)
+	}
+
+	if rareOnly != 1 {
+		test.Errorf("Expected 1 dizziness leaf under Rare, got %d.", rareOnly)
+	}
+}
+
+// ============
+// Walk Testing
+// ============
+
+// TestWalkVisitOrder verifies the pre-order and post-order visit sequences, and that the
+// pre-order walk agrees with Fold.
+func TestWalkVisitOrder(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+	var preOrder []string
+	var postOrder []string
+
+	// Act.
+	root.WalkPreOrder(func(node *Node) bool {
+		preOrder = append(preOrder, node.Value)
+
+		return true
+	})
+
+	root.WalkPostOrder(func(node *Node) bool {
+		postOrder = append(postOrder, node.Value)
+
+		return true
+	})
+
+	// Assert.
+	var folded []string = Fold(root, []string{}, func(order []string, node *Node) []string {
+		return append(order, node.Value)
+	})
+
+	if !reflect.DeepEqual(preOrder, folded) {
+		test.Errorf("WalkPreOrder order = %v; want %v.", preOrder, folded)
+	}
+
+	var expected []string = []string{"A1", "A2", "A", "B1", "B", "Root"}
+
+	if !reflect.DeepEqual(postOrder, expected) {
+		test.Errorf("WalkPostOrder order = %v; want %v.", postOrder, expected)
+	}
+}
+
+// TestWalkStopsEarly verifies that returning false from visit stops both walks at that node.
+func TestWalkStopsEarly(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	var tests = []struct {
+		name     string
+		walk     func(visit func(*Node) bool)
+		expected []string
+	}{
+		{name: "WalkPreOrder", walk: root.WalkPreOrder, expected: []string{"Root", "A", "A1", "A2"}},
+		{name: "WalkPostOrder", walk: root.WalkPostOrder, expected: []string{"A1", "A2"}},
+	}
+
+	for _, specificTest := range tests {
+		var visited []string
+
+		// Act.
+		specificTest.walk(func(node *Node) bool {
+			visited = append(visited, node.Value)
+
+			return node.Value != "A2"
+		})
+
+		// Assert.
+		if !reflect.DeepEqual(visited, specificTest.expected) {
+			test.Errorf("%s visited %v before stopping; want %v.", specificTest.name, visited, specificTest.expected)
+		}
+	}
+}
+
+// TestWalkDeepTree walks a chain far deeper than recursion could comfortably handle and checks
+// that every node is visited in the expected order.
+func TestWalkDeepTree(test *testing.T) {
+	// Arrange.
+	const DEPTH int = 1000000
+
+	var root *Node = &Node{Value: "0"}
+	var leaf *Node = root
+
+	for level := 1; level < DEPTH; level++ {
+		leaf = leaf.AddChild(fmt.Sprint(level))
+	}
+
+	var preOrderCount int = 0
+	var firstPostOrder *Node = nil
+	var lastPostOrder *Node = nil
+
+	// Act.
+	root.WalkPreOrder(func(node *Node) bool {
+		preOrderCount++
+
+		return true
+	})
+
+	root.WalkPostOrder(func(node *Node) bool {
+		if firstPostOrder == nil {
+			firstPostOrder = node
+		}
+
+		lastPostOrder = node
+
+		return true
+	})
+
+	// Assert.
+	if preOrderCount != DEPTH {
+		test.Errorf("WalkPreOrder visited %d nodes; want %d.", preOrderCount, DEPTH)
+	}
+
+	if firstPostOrder != leaf || lastPostOrder != root {
+		test.Error("Expected WalkPostOrder to start at the deepest leaf and end at the root.")
+	}
+}
+
+// ================
+// Metadata Testing
+// ================
+
+// TestMetaSetAndGet verifies that metadata is stored lazily, read back with its presence flag,
+// and overwritten by a later SetMeta.
+func TestMetaSetAndGet(test *testing.T) {
+	// Arrange.
+	var root *Node = buildPharmaceuticalTree()
+	var absorption *Node = root.FindPath("Desvenlafaxine", "Pharmacokinetics", "Absorption")
+
+	if absorption.Meta != nil {
+		test.Fatal("Expected Meta to stay nil until the first SetMeta.")
+	}
+
+	if _, exists := absorption.GetMeta("source"); exists {
+		test.Fatal("Expected GetMeta on a node without metadata to report a missing key.")
+	}
+
+	// Act.
+	absorption.SetMeta("source", "https://example.org/desvenlafaxine")
+	absorption.SetMeta("confidence", 0.8)
+	absorption.SetMeta("confidence", 0.95)
+
+	// Assert.
+	if source, exists := absorption.GetMeta("source"); !exists || source != "https://example.org/desvenlafaxine" {
+		test.Errorf("GetMeta(%q) = %v, %v; want the stored URL.", "source", source, exists)
+	}
+
+	if confidence, exists := absorption.GetMeta("confidence"); !exists || confidence != 0.95 {
+		test.Errorf("GetMeta(%q) = %v, %v; want 0.95, true.", "confidence", confidence, exists)
+	}
+
+	if _, exists := absorption.GetMeta("missing"); exists {
+		test.Error("Expected GetMeta to report a key that was never set as missing.")
+	}
+}
+
+// TestCloneCopiesMeta verifies that metadata survives a clone and that the clone's metadata can
+// be changed without affecting the original.
+func TestCloneCopiesMeta(test *testing.T) {
+	// Arrange.
+	var root *Node = buildExampleTree()
+
+	root.SetMeta("version", 1)
+	root.Children[0].Children[1].SetMeta("confidence", 0.7)
+
+	// Act.
+	var clone *Node = root.Clone()
+
+	clone.SetMeta("version", 2)
+	clone.Children[0].Children[1].SetMeta("reviewed", true)
+
+	// Assert.
+	if version, _ := clone.GetMeta("version"); version != 2 {
+		test.Errorf("Expected the clone's version to be 2, got %v.", version)
+	}
+
+	if version, _ := root.GetMeta("version"); version != 1 {
+		test.Errorf("Expected the original's version to stay 1, got %v.", version)
+	}
+
+	if confidence, exists := clone.Children[0].Children[1].GetMeta("confidence"); !exists || confidence != 0.7 {
+		test.Errorf("Expected the cloned leaf to keep confidence 0.7, got %v, %v.", confidence, exists)
+	}
+
+	if _, exists := root.Children[0].Children[1].GetMeta("reviewed"); exists {
+		test.Error("Expected metadata added to the clone not to appear on the original.")
+	}
+
+	if clone.Children[1].Meta != nil {
+		test.Error("Expected nodes without metadata to be cloned without a Meta map.")
+	}
+}
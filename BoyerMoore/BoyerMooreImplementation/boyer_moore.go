@@ -14,6 +14,23 @@
 //	- Full preprocessing of bad character and good suffix tables
 //	- Maximum shift selection per iteration for optimal skipping
 //	- Returns all starting indices of pattern occurrences in the input text
+//	- Resuming a search from a rune offset without re-finding earlier matches
+//	- Case-insensitive search using Unicode simple case folding
+//	- Precompiled, reusable matchers for searching one pattern across many texts
+//	- Streaming search over an io.Reader without loading the whole input
+//	- Counting occurrences without allocating an index slice
+//	- Locating only the first occurrence, stopping as soon as it is found
+//	- Pull-style iteration over matches, scanning only as far as the caller reads
+//	- Membership check that stops at the first match
+//	- Non-overlapping match mode that resumes after the end of each match
+//	- Whole-word matching bounded by non-word runes or the edges of the text
+//	- Matches paired with a snippet of up to radius runes of surrounding context
+//	- Byte-exact search over raw binary data with a 256-entry bad character table
+//	- Replacing all non-overlapping matches while preserving the text between them
+//	- Approximate search allowing up to k mismatched runes (Tarhio-Ukkonen shifts)
+//	- Boyer-Moore-Horspool variant shifting only on the rune under the window's end
+//	- Galil rule: after a match, the overlap with the previous occurrence is not
+//	  compared again, so searching periodic text runs in linear time
 //
 // Author:      Braiden Gole
 // Created:     July 25, 2025
@@ -21,6 +38,16 @@
 // ===================================================================================
 package boyermooreimplementation
 
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// readerWindowRunes is the number of new runes SearchReader scans at a time.
+const readerWindowRunes int = 4096
+
 // maximum returns the greater of two integer values.
 // Used to determine the optimal shift between the bad character and good suffix heuristics.
 func maximum(compare int, against int) int {
@@ -31,6 +58,15 @@ func maximum(compare int, against int) int {
 	return against
 }
 
+// minimum returns the smaller of two integer values.
+func minimum(compare int, against int) int {
+	if compare < against {
+		return compare
+	}
+
+	return against
+}
+
 // preprocessBadCharacterTable creates the bad character table for the given pattern.
 // The table maps each rune in the pattern to its last occurrence index.
 // This table helps determine how far to shift the pattern when a mismatch occurs.
@@ -44,6 +80,22 @@ func preprocessBadCharacterTable(patternRunes []rune) map[rune]int {
 	return table
 }
 
+// computeBadCharacterShift returns the bad character heuristic's shift after a mismatch of the
+// text rune against pattern position patternIndex: the distance that aligns the rune with its
+// last occurrence in the pattern, at least 1. A rune absent from the table has last occurrence
+// -1, so the pattern moves entirely past it (patternIndex + 1).
+func computeBadCharacterShift(badCharacterTable map[rune]int, character rune, patternIndex int) int {
+	lastKnownOccurrence, found := badCharacterTable[character]
+
+	// The map's zero value would wrongly place absent runes at index 0.
+	if !found {
+		lastKnownOccurrence = -1
+	}
+
+	// Ensure at least one shift forward.
+	return maximum(patternIndex-lastKnownOccurrence, 1)
+}
+
 // preprocessSuffixes computes the suffix lengths array for the pattern.
 // For each position, it calculates the length of the longest suffix
 // of the substring pattern[0:index] that matches a suffix of the entire pattern.
@@ -123,68 +175,718 @@ func preprocessGoodSuffixTable(pattern []rune) []int {
 	return goodSuffixShifts
 }
 
+// foldRune maps a rune to a canonical member of its Unicode simple case-folding orbit
+// (the smallest rune in it), so two runes fold to the same value exactly when
+// strings.EqualFold would consider them equal. For example 'K', 'k' and the Kelvin
+// sign U+212A all fold to 'K'.
+func foldRune(character rune) rune {
+	var folded rune = character
+
+	for next := unicode.SimpleFold(character); next != character; next = unicode.SimpleFold(next) {
+		if next < folded {
+			folded = next
+		}
+	}
+
+	return folded
+}
+
+// foldRunes returns a copy of the runes with every rune replaced by its folded form.
+func foldRunes(characters []rune) []rune {
+	var folded []rune = make([]rune, len(characters))
+
+	for index, character := range characters {
+		folded[index] = foldRune(character)
+	}
+
+	return folded
+}
+
+// Matcher holds a pattern together with its precomputed bad character and good suffix
+// tables, so the same pattern can be searched for in many texts without repeating the
+// preprocessing. A Matcher is never modified after compilation and is safe for concurrent use.
+type Matcher struct {
+	patternRunes         []rune
+	badCharacterTable    map[rune]int
+	goodSuffixShiftTable []int
+	fold                 bool
+}
+
+// compile builds a Matcher from the pattern runes, which must already be folded when fold is true.
+func compile(patternRunes []rune, fold bool) *Matcher {
+	var matcher *Matcher = &Matcher{patternRunes: patternRunes, fold: fold}
+
+	// An empty pattern has no tables; Search reports no matches for it.
+	if len(patternRunes) > 0 {
+		matcher.badCharacterTable = preprocessBadCharacterTable(patternRunes)
+		matcher.goodSuffixShiftTable = preprocessGoodSuffixTable(patternRunes)
+	}
+
+	return matcher
+}
+
+// scan runs the Boyer-Moore search phase over the text using the matcher's precomputed tables,
+// calling onMatch with the starting index of every occurrence in increasing order until
+// onMatch returns false. For a folding matcher every text rune is case-folded before it is
+// compared or looked up. When nonOverlapping is true, scanning resumes after the end of each
+// match instead of at the next possible alignment. An empty pattern matches nowhere.
+//
+// The Galil rule is applied after every overlapping match: the pattern shifts by its period,
+// so its first len(pattern) - period runes are already known to match the text and are not
+// compared again. This bounds the comparisons for periodic inputs such as "aa...a" by O(n).
+func (matcher *Matcher) scan(textRunes []rune, nonOverlapping bool, onMatch func(index int) bool) {
+	matcher.scanCounting(textRunes, nonOverlapping, onMatch)
+}
+
+// scanCounting performs the scan described on scan and returns the number of rune comparisons
+// it made, so tests and benchmarks can check the Galil rule's linear bound.
+func (matcher *Matcher) scanCounting(textRunes []rune, nonOverlapping bool, onMatch func(index int) bool) int {
+	var patternRunes []rune = matcher.patternRunes
+	var badCharacterTable map[rune]int = matcher.badCharacterTable
+	var goodSuffixShiftTable []int = matcher.goodSuffixShiftTable
+
+	var textLength int = len(textRunes)
+	var patternLength int = len(patternRunes)
+
+	if patternLength == 0 {
+		return 0
+	}
+
+	var currentTextAlignment int = 0
+	var patternIndex int = 0
+	var knownMatchLength int = 0
+	var comparisons int = 0
+
+	var textCharacter rune = ' '
+	var badCharacterShift int = 0
+	var goodSuffixShift int = 0
+
+	// Loop while pattern can still fit the remaining text.
+	for currentTextAlignment <= textLength-patternLength {
+		patternIndex = patternLength - 1
+
+		// Compare pattern with text from end of pattern, stopping at the known-matching prefix.
+		for patternIndex >= knownMatchLength {
+			textCharacter = textRunes[currentTextAlignment+patternIndex]
+
+			if matcher.fold {
+				textCharacter = foldRune(textCharacter)
+			}
+
+			comparisons++
+
+			if patternRunes[patternIndex] != textCharacter {
+				break
+			}
+
+			patternIndex--
+		}
+
+		// Full match found.
+		if patternIndex < knownMatchLength {
+			if !onMatch(currentTextAlignment) {
+				return comparisons
+			}
+
+			// Skip past the whole match, or only as far as the good suffix rule allows.
+			if nonOverlapping {
+				currentTextAlignment += patternLength
+				knownMatchLength = 0
+			} else {
+				// Galil rule: the shifted pattern's prefix overlaps the match just found.
+				currentTextAlignment += goodSuffixShiftTable[0]
+				knownMatchLength = patternLength - goodSuffixShiftTable[0]
+			}
+		} else {
+			knownMatchLength = 0
+
+			// Mismatch found, use heuristics to determine shift.
+			badCharacterShift = computeBadCharacterShift(badCharacterTable, textCharacter, patternIndex)
+
+			// Calculate shift using good suffix rule.
+			goodSuffixShift = goodSuffixShiftTable[patternIndex]
+
+			// Shift by the maximum of both heuristics to maximize skipping.
+			currentTextAlignment += maximum(badCharacterShift, goodSuffixShift)
+		}
+	}
+
+	return comparisons
+}
+
+// Compile preprocesses the pattern once and returns a Matcher for case-sensitive searches.
+func Compile(pattern string) *Matcher {
+	return compile([]rune(pattern), false)
+}
+
+// CompileFold preprocesses the folded pattern once and returns a Matcher for case-insensitive
+// searches, with the same matching rules as BoyerMooreSearchFold.
+func CompileFold(pattern string) *Matcher {
+	return compile(foldRunes([]rune(pattern)), true)
+}
+
+// Search returns the starting indices, counted in runes, of every occurrence of the
+// matcher's pattern in the text.
+func (matcher *Matcher) Search(text string) []int {
+	var textRunes []rune = []rune(text)
+
+	// Return empty if pattern is empty or longer than the text.
+	if len(matcher.patternRunes) == 0 || len(textRunes) < len(matcher.patternRunes) {
+		return nil
+	}
+
+	var indices []int
+
+	matcher.scan(textRunes, false, func(index int) bool {
+		indices = append(indices, index)
+
+		return true
+	})
+
+	// Return all found indices.
+	return indices
+}
+
+// SearchNonOverlapping returns the starting rune indices of non-overlapping occurrences of the
+// matcher's pattern, scanning left to right and resuming right after the end of each match.
+func (matcher *Matcher) SearchNonOverlapping(text string) []int {
+	var indices []int
+
+	matcher.scan([]rune(text), true, func(index int) bool {
+		indices = append(indices, index)
+
+		return true
+	})
+
+	return indices
+}
+
+// Count returns the number of occurrences of the matcher's pattern in the text, overlapping
+// ones included, without building a slice of their positions.
+func (matcher *Matcher) Count(text string) int {
+	var textRunes []rune = []rune(text)
+	var count int = 0
+
+	// An empty pattern or one longer than the text has no occurrences.
+	if len(matcher.patternRunes) == 0 || len(textRunes) < len(matcher.patternRunes) {
+		return 0
+	}
+
+	matcher.scan(textRunes, false, func(index int) bool {
+		count++
+
+		return true
+	})
+
+	return count
+}
+
+// Index returns the rune index of the first occurrence of the matcher's pattern in the text,
+// or -1 if it does not occur. Scanning stops at the first match. As with strings.Index, an
+// empty pattern matches at index 0.
+func (matcher *Matcher) Index(text string) int {
+	var textRunes []rune = []rune(text)
+	var first int = -1
+
+	if len(matcher.patternRunes) == 0 {
+		return 0
+	}
+
+	if len(textRunes) < len(matcher.patternRunes) {
+		return -1
+	}
+
+	matcher.scan(textRunes, false, func(index int) bool {
+		first = index
+
+		// Stop at the first match.
+		return false
+	})
+
+	return first
+}
+
+// Iterator returns a pull-style iterator over the rune indices of the occurrences of the
+// matcher's pattern in the text, overlapping ones included. Each call yields the next index,
+// in the same order as Search, and true; once the matches are exhausted every call returns
+// -1 and false. The text is only scanned as far as needed, so a caller that stops early never
+// pays for the remaining matches.
+func (matcher *Matcher) Iterator(text string) func() (int, bool) {
+	var textRunes []rune = []rune(text)
+	var next int = 0
+	var done bool = len(matcher.patternRunes) == 0
+
+	return func() (int, bool) {
+		var found int = -1
+
+		if done {
+			return -1, false
+		}
+
+		// Resume one rune past the previous match and stop at the first occurrence.
+		if len(textRunes)-next >= len(matcher.patternRunes) {
+			matcher.scan(textRunes[next:], false, func(index int) bool {
+				found = next + index
+
+				return false
+			})
+		}
+
+		if found == -1 {
+			done = true
+
+			return -1, false
+		}
+
+		next = found + 1
+
+		return found, true
+	}
+}
+
+// SearchReader searches a stream for the matcher's pattern without loading it into memory,
+// calling emit with the absolute starting offset, counted in runes like Search, of every match
+// in increasing order.
+//
+// The input is decoded through a buffered reader and scanned in windows of runes. After each
+// window the last len(pattern)-1 runes are kept as the start of the next one, so a match that
+// spans two windows is found exactly once: it cannot fit entirely in the retained tail, and
+// every match reported earlier starts before it.
+//
+// It returns nil once the reader is exhausted, or the first read error other than io.EOF; in
+// that case the matches within the runes read before the error have already been emitted.
+func (matcher *Matcher) SearchReader(reader io.Reader, emit func(offset int)) error {
+	var patternLength int = len(matcher.patternRunes)
+
+	if patternLength == 0 {
+		return nil
+	}
+
+	var bufferedReader *bufio.Reader = bufio.NewReader(reader)
+
+	var window []rune = make([]rune, 0, readerWindowRunes+patternLength-1)
+	var windowOffset int = 0
+	var overlap int = patternLength - 1
+
+	for {
+		character, _, err := bufferedReader.ReadRune()
+
+		if err != nil {
+			// Scan whatever remains after the last full window.
+			matcher.emitMatches(window, windowOffset, emit)
+
+			if err != io.EOF {
+				return err
+			}
+
+			return nil
+		}
+
+		window = append(window, character)
+
+		if len(window) == cap(window) {
+			matcher.emitMatches(window, windowOffset, emit)
+
+			// Retain the overlap tail so matches spanning the boundary are found in the next window.
+			windowOffset += len(window) - overlap
+			window = append(window[:0], window[len(window)-overlap:]...)
+		}
+	}
+}
+
+// emitMatches scans one window of runes and reports every match at its absolute offset.
+func (matcher *Matcher) emitMatches(window []rune, windowOffset int, emit func(offset int)) {
+	matcher.scan(window, false, func(index int) bool {
+		emit(windowOffset + index)
+
+		return true
+	})
+}
+
 // BoyerMooreSearch performs the Boyer-Moore string search algorithm.
 // It searches for all occurrences of the pattern in the given text
 // and returns a slice of starting indices where the pattern is found.
 // Utilizes both bad character and good suffix heuristics for efficient searching.
+// The tables are rebuilt on every call; use Compile to search for one pattern repeatedly.
 func BoyerMooreSearch(text string, pattern string) []int {
+	return Compile(pattern).Search(text)
+}
+
+// BoyerMooreSearchFrom works like BoyerMooreSearch, but only reports occurrences beginning at
+// or after the start rune index, so a caller resuming a scan does not find earlier matches
+// again. Only the text from start onwards is scanned, and the returned indices are still
+// counted from the beginning of the text. A negative start is treated as 0, and a start past
+// the end of the text returns no matches.
+func BoyerMooreSearchFrom(text string, pattern string, start int) []int {
+	var matcher *Matcher = Compile(pattern)
+	var textRunes []rune = []rune(text)
 	var indices []int
 
-	// Convert strings to rune slices to correctly handle Unicode.
+	start = maximum(start, 0)
+
+	if len(matcher.patternRunes) == 0 || start > len(textRunes) || len(textRunes)-start < len(matcher.patternRunes) {
+		return indices
+	}
+
+	matcher.scan(textRunes[start:], false, func(index int) bool {
+		indices = append(indices, start+index)
+
+		return true
+	})
+
+	return indices
+}
+
+// BoyerMooreSearchNonOverlapping works like BoyerMooreSearch, but after a match at index i the
+// search resumes at i + len(pattern), so reported matches never overlap. For example, "aaa"
+// in "aaaaaaa" is found at [0 3] rather than [0 1 2 3 4].
+func BoyerMooreSearchNonOverlapping(text string, pattern string) []int {
+	return Compile(pattern).SearchNonOverlapping(text)
+}
+
+// isWordRune reports whether the rune is part of a word: a Unicode letter or digit.
+func isWordRune(character rune) bool {
+	return unicode.IsLetter(character) || unicode.IsDigit(character)
+}
+
+// BoyerMooreSearchWholeWord works like BoyerMooreSearch, but only reports occurrences that are
+// bounded on both sides by a non-word rune or an edge of the text, so "go" is found in
+// "let's go now" but not inside "google". Word runes are Unicode letters and digits. Indices
+// are counted in runes.
+func BoyerMooreSearchWholeWord(text string, pattern string) []int {
+	var matcher *Matcher = Compile(pattern)
 	var textRunes []rune = []rune(text)
-	var patternRunes []rune = []rune(pattern)
+	var patternLength int = len(matcher.patternRunes)
+	var indices []int
 
-	var textLength int = len(textRunes)
-	var patternLength int = len(patternRunes)
+	if patternLength == 0 || len(textRunes) < patternLength {
+		return indices
+	}
+
+	matcher.scan(textRunes, false, func(index int) bool {
+		var end int = index + patternLength
+
+		// Keep the match only if neither neighbour continues a word.
+		if (index == 0 || !isWordRune(textRunes[index-1])) && (end == len(textRunes) || !isWordRune(textRunes[end])) {
+			indices = append(indices, index)
+		}
+
+		return true
+	})
+
+	return indices
+}
+
+// ContextMatch is one occurrence reported by BoyerMooreSearchContext: the rune index of the
+// match and a snippet of the text containing the match and its surrounding runes.
+type ContextMatch struct {
+	Index   int
+	Snippet string
+}
+
+// BoyerMooreSearchContext works like BoyerMooreSearch, but pairs every occurrence with a
+// snippet for previews such as grep-style output. The snippet holds the match plus up to radius
+// runes on each side, clamped at the edges of the text, and is cut on rune boundaries so
+// multi-byte characters are never split. A negative radius is treated as 0.
+func BoyerMooreSearchContext(text string, pattern string, radius int) []ContextMatch {
+	var matcher *Matcher = Compile(pattern)
+	var textRunes []rune = []rune(text)
+	var patternLength int = len(matcher.patternRunes)
+	var matches []ContextMatch
+
+	radius = maximum(radius, 0)
+
+	if patternLength == 0 || len(textRunes) < patternLength {
+		return matches
+	}
+
+	matcher.scan(textRunes, false, func(index int) bool {
+		var start int = maximum(index-radius, 0)
+		var end int = minimum(index+patternLength+radius, len(textRunes))
+
+		matches = append(matches, ContextMatch{Index: index, Snippet: string(textRunes[start:end])})
+
+		return true
+	})
+
+	return matches
+}
+
+// BoyerMooreReplaceAll returns a copy of the text with every non-overlapping occurrence of the
+// pattern, found left to right as by BoyerMooreSearchNonOverlapping, replaced by replacement.
+// The text between matches is copied byte for byte from the original, so even invalid UTF-8
+// outside the matches is preserved. An empty pattern returns the text unchanged.
+func BoyerMooreReplaceAll(text string, pattern string, replacement string) string {
+	var matcher *Matcher = Compile(pattern)
+	var patternLength int = len(matcher.patternRunes)
+
+	if patternLength == 0 {
+		return text
+	}
+
+	// Byte offset at which each rune starts, plus the end of the text, to map rune indices back.
+	var runeStarts []int = make([]int, 0, len(text)+1)
+
+	for byteOffset := range text {
+		runeStarts = append(runeStarts, byteOffset)
+	}
+
+	runeStarts = append(runeStarts, len(text))
+
+	var builder strings.Builder
+	var copiedUpTo int = 0
+
+	matcher.scan([]rune(text), true, func(index int) bool {
+		builder.WriteString(text[copiedUpTo:runeStarts[index]])
+		builder.WriteString(replacement)
+		copiedUpTo = runeStarts[index+patternLength]
+
+		return true
+	})
+
+	// No match: return the original string without copying it.
+	if copiedUpTo == 0 {
+		return text
+	}
+
+	builder.WriteString(text[copiedUpTo:])
+
+	return builder.String()
+}
+
+// BoyerMooreCount returns the number of occurrences of the pattern in the text, using the
+// same skip logic as BoyerMooreSearch but only incrementing a counter, so no index slice
+// is allocated. An empty pattern or one longer than the text returns 0.
+func BoyerMooreCount(text string, pattern string) int {
+	return Compile(pattern).Count(text)
+}
+
+// BoyerMooreIndex returns the index of the first occurrence of the pattern in the text, counted
+// in runes like BoyerMooreSearch, or -1 if it is absent. Unlike BoyerMooreSearch it stops at the
+// first match. An empty pattern returns 0, following the strings.Index convention.
+func BoyerMooreIndex(text string, pattern string) int {
+	return Compile(pattern).Index(text)
+}
+
+// BoyerMooreContains reports whether the pattern occurs in the text, stopping at the first
+// match instead of collecting every index. Following strings.Contains, an empty pattern is
+// contained in every text, including the empty one.
+func BoyerMooreContains(text string, pattern string) bool {
+	return Compile(pattern).Index(text) != -1
+}
+
+// BoyerMooreSearchFold performs a case-insensitive Boyer-Moore search and returns the
+// starting indices of all matches, counted in runes of the original text like BoyerMooreSearch.
+//
+// Matching uses Unicode simple case folding, the same equivalence as strings.EqualFold:
+// the bad character and good suffix tables are built from the folded pattern, and each
+// text rune is folded as it is compared. Simple folding maps one rune to one rune, so runes
+// whose folded form has a different byte length (such as the Kelvin sign U+212A, which
+// folds with 'k') are still matched and reported at the correct rune index. Multi-rune
+// foldings such as 'ß' and "ss" are not considered equal.
+func BoyerMooreSearchFold(text string, pattern string) []int {
+	return CompileFold(pattern).Search(text)
+}
+
+// BoyerMooreSearchBytes performs the Boyer-Moore search directly on bytes and returns the byte
+// offsets of all (possibly overlapping) occurrences of the pattern in the text. Nothing is
+// decoded as UTF-8, so invalid or binary sequences are matched exactly, byte for byte.
+//
+// The bad character table is a 256-entry array indexed by byte value, with -1 for bytes absent
+// from the pattern. The good suffix table is shared with the rune-based search: each pattern
+// byte is widened to a rune by value, which preserves byte equality.
+func BoyerMooreSearchBytes(text []byte, pattern []byte) []int {
+	var indices []int
+
+	var textLength int = len(text)
+	var patternLength int = len(pattern)
 
 	// Return empty if pattern is empty or longer than the text.
 	if patternLength == 0 || textLength < patternLength {
 		return indices
 	}
 
-	// Preprocess tables used for efficient skipping.
-	var badCharacterTable map[rune]int = preprocessBadCharacterTable(patternRunes)
-	var goodSuffixShiftTable []int = preprocessGoodSuffixTable(patternRunes)
+	var badCharacterTable [256]int
 
-	var currentTextAlignment int = 0
-	var patternIndex int = 0
+	for index := range badCharacterTable {
+		badCharacterTable[index] = -1
+	}
 
-	var mismatchedTextCharacter rune = ' '
-	var lastKnownOccurrence int = 0
+	for index, value := range pattern {
+		badCharacterTable[value] = index
+	}
 
-	var badCharacterShift int = 0
-	var goodSuffixShift int = 0
+	// Widen bytes one-to-one; this is not UTF-8 decoding.
+	var widenedPattern []rune = make([]rune, patternLength)
+
+	for index, value := range pattern {
+		widenedPattern[index] = rune(value)
+	}
+
+	var goodSuffixShiftTable []int = preprocessGoodSuffixTable(widenedPattern)
+
+	var currentTextAlignment int = 0
+	var patternIndex int = 0
+	var knownMatchLength int = 0
 
 	// Loop while pattern can still fit the remaining text.
 	for currentTextAlignment <= textLength-patternLength {
 		patternIndex = patternLength - 1
 
-		// Compare pattern with text from end of pattern.
-		for patternIndex >= 0 && patternRunes[patternIndex] == textRunes[currentTextAlignment+patternIndex] {
+		// Compare pattern with text from end of pattern, stopping at the known-matching prefix.
+		for patternIndex >= knownMatchLength && pattern[patternIndex] == text[currentTextAlignment+patternIndex] {
 			patternIndex--
 		}
 
 		// Full match found.
-		if patternIndex < 0 {
+		if patternIndex < knownMatchLength {
 			indices = append(indices, currentTextAlignment)
+
+			// Galil rule, as in the rune-based search.
 			currentTextAlignment += goodSuffixShiftTable[0]
+			knownMatchLength = patternLength - goodSuffixShiftTable[0]
 		} else {
-			// Mismatch found, use heuristics to determine shift.
-			mismatchedTextCharacter = textRunes[currentTextAlignment+patternIndex]
-			lastKnownOccurrence = badCharacterTable[mismatchedTextCharacter]
-			badCharacterShift = patternIndex - lastKnownOccurrence
+			knownMatchLength = 0
 
-			// Ensure at least one shift forward.
-			if badCharacterShift < 1 {
-				badCharacterShift = 1
+			// Mismatch found, shift by the larger of both heuristics (at least one).
+			currentTextAlignment += maximum(
+				maximum(patternIndex-badCharacterTable[text[currentTextAlignment+patternIndex]], 1),
+				goodSuffixShiftTable[patternIndex])
+		}
+	}
+
+	// Return all found indices.
+	return indices
+}
+
+// HorspoolSearch returns the rune indices of all (possibly overlapping) occurrences of the
+// pattern in the text using the Boyer-Moore-Horspool simplification: there is no good suffix
+// table, and after every alignment, matched or not, the pattern shifts by the bad character
+// distance of the text rune under its last position. That distance is how far the rune's last
+// occurrence in pattern[:len(pattern)-1] lies from the end, or the whole pattern length if it
+// does not occur there. The results are the same as BoyerMooreSearch; on natural-language text
+// the cheaper per-alignment work often makes it faster.
+func HorspoolSearch(text string, pattern string) []int {
+	var indices []int
+
+	var textRunes []rune = []rune(text)
+	var patternRunes []rune = []rune(pattern)
+
+	var textLength int = len(textRunes)
+	var patternLength int = len(patternRunes)
+
+	// Return empty if pattern is empty or longer than the text.
+	if patternLength == 0 || textLength < patternLength {
+		return indices
+	}
+
+	// The last pattern rune is left out so that a match never shifts by zero.
+	var shiftTable map[rune]int = make(map[rune]int)
+
+	for index, character := range patternRunes[:patternLength-1] {
+		shiftTable[character] = patternLength - 1 - index
+	}
+
+	var currentTextAlignment int = 0
+
+	for currentTextAlignment <= textLength-patternLength {
+		var patternIndex int = patternLength - 1
+
+		for patternIndex >= 0 && patternRunes[patternIndex] == textRunes[currentTextAlignment+patternIndex] {
+			patternIndex--
+		}
+
+		if patternIndex < 0 {
+			indices = append(indices, currentTextAlignment)
+		}
+
+		shift, found := shiftTable[textRunes[currentTextAlignment+patternLength-1]]
+
+		if !found {
+			shift = patternLength
+		}
+
+		currentTextAlignment += shift
+	}
+
+	return indices
+}
+
+// BoyerMooreSearchApproximate returns the rune indices at which the pattern aligns with the text
+// with at most k differing runes (Hamming distance; no insertions or deletions).
+//
+// Each alignment is compared from the right and abandoned as soon as more than k mismatches
+// are seen. The shift uses the bad character heuristic generalized by Tarhio and Ukkonen: a
+// shift is only safe if at least one of the text runes under the last k+1 pattern positions
+// could then match, so the pattern moves by the smallest bad character shift among those
+// positions (at most len(pattern) - k). A negative k matches nowhere, and a k of at least the
+// pattern length matches at every alignment.
+func BoyerMooreSearchApproximate(text string, pattern string, k int) []int {
+	var indices []int
+
+	var textRunes []rune = []rune(text)
+	var patternRunes []rune = []rune(pattern)
+
+	var textLength int = len(textRunes)
+	var patternLength int = len(patternRunes)
+
+	// Return empty if pattern is empty or longer than the text, or no mismatch budget is valid.
+	if patternLength == 0 || textLength < patternLength || k < 0 {
+		return indices
+	}
+
+	// Every alignment is within k mismatches.
+	if k >= patternLength {
+		for alignment := 0; alignment <= textLength-patternLength; alignment++ {
+			indices = append(indices, alignment)
+		}
+
+		return indices
+	}
+
+	// For each of the last k+1 pattern positions, the last occurrence of every rune to its left.
+	var firstCheckedPosition int = patternLength - k - 1
+	var lastOccurrenceTables []map[rune]int = make([]map[rune]int, k+1)
+
+	for offset := range lastOccurrenceTables {
+		lastOccurrenceTables[offset] = preprocessBadCharacterTable(patternRunes[:firstCheckedPosition+offset])
+	}
+
+	var currentTextAlignment int = 0
+	var mismatches int = 0
+	var shift int = 0
+
+	// Loop while pattern can still fit the remaining text.
+	for currentTextAlignment <= textLength-patternLength {
+		mismatches = 0
+
+		// Compare from the end of the pattern, giving up once the budget is exceeded.
+		for patternIndex := patternLength - 1; patternIndex >= 0 && mismatches <= k; patternIndex-- {
+			if patternRunes[patternIndex] != textRunes[currentTextAlignment+patternIndex] {
+				mismatches++
 			}
+		}
 
-			// Calculate shift using good suffix rule.
-			goodSuffixShift = goodSuffixShiftTable[patternIndex]
+		if mismatches <= k {
+			indices = append(indices, currentTextAlignment)
+		}
 
-			// Shift by the maximum of both heuristics to maximize skipping.
-			currentTextAlignment += maximum(badCharacterShift, goodSuffixShift)
+		// Smallest bad character shift over the last k+1 positions; never more than m - k.
+		shift = patternLength - k
+
+		for offset, lastOccurrences := range lastOccurrenceTables {
+			var position int = firstCheckedPosition + offset
+
+			lastKnownOccurrence, found := lastOccurrences[textRunes[currentTextAlignment+position]]
+
+			if !found {
+				lastKnownOccurrence = -1
+			}
+
+			shift = minimum(shift, position-lastKnownOccurrence)
 		}
+
+		currentTextAlignment += shift
 	}
 
 	// Return all found indices.
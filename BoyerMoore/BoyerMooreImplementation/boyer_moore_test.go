@@ -12,6 +12,27 @@
 //   - Cases with no matches
 //   - Edge cases like empty patterns or patterns longer than text
 //   - Support for Unicode characters and overlapping patterns
+//   - Case-insensitive search with Unicode case folding
+//   - Reusing a compiled Matcher across many texts, with benchmarks
+//     comparing it against repeated BoyerMooreSearch calls
+//   - Streaming search over small-buffer readers, including matches that
+//     span window boundaries
+//   - Bad character shifts for runes absent from the pattern
+//   - Non-overlapping matches compared with overlapping ones
+//   - Replacing all non-overlapping matches with shorter or longer strings
+//   - Approximate search with up to k mismatches, checked against a naive search
+//   - Counting occurrences, checked against the search results
+//   - Boyer-Moore-Horspool search agreeing with BoyerMooreSearch on the shared cases
+//   - Byte-exact search over binary and invalid UTF-8 data
+//   - Galil rule: agreement with a naive search on random periodic inputs, a
+//     linear comparison bound, and a benchmark reporting comparisons per search
+//   - Finding only the first occurrence, including the empty-pattern convention
+//   - Whole-word matches bounded by non-word runes or the text edges
+//   - Context snippets clamped at the text edges without splitting multi-byte runes
+//   - Membership checks, matching strings.Contains for empty patterns
+//   - Resuming a search from a rune offset, with clamped and out-of-range starts
+//   - Pull-style match iteration agreeing with Search, early stopping, and
+//     repeated exhaustion after the last match
 //
 // Author:      Braiden Gole
 // Created:     July 25, 2025
@@ -20,7 +41,14 @@
 package boyermooreimplementation
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"unicode/utf8"
 )
 
 // equalIntSlices compares two integer slices for equality.
@@ -40,81 +68,1239 @@ func equalIntSlices(compare []int, against []int) bool {
 	return true
 }
 
+// searchTests holds the table-driven cases shared by the BoyerMooreSearch and BoyerMooreCount tests.
+var searchTests = []struct {
+	name     string
+	text     string
+	pattern  string
+	expected []int
+}{
+	{
+		name:     "Example test",
+		text:     "hello",
+		pattern:  "ll",
+		expected: []int{2},
+	},
+	{
+		name:     "Exact match in middle",
+		text:     "say hello to the world",
+		pattern:  "hello",
+		expected: []int{4},
+	},
+	{
+		name:     "Multiple occurrences",
+		text:     "abracadabra",
+		pattern:  "abra",
+		expected: []int{0, 7},
+	},
+	{
+		name:     "No match",
+		text:     "abcdefg",
+		pattern:  "xyz",
+		expected: []int{},
+	},
+	{
+		name:     "Pattern equals text",
+		text:     "pattern",
+		pattern:  "pattern",
+		expected: []int{0},
+	},
+	{
+		name:     "Empty pattern",
+		text:     "nonempty",
+		pattern:  "",
+		expected: []int{},
+	},
+	{
+		name:     "Pattern longer than text",
+		text:     "short",
+		pattern:  "longpattern",
+		expected: []int{},
+	},
+	{
+		name:     "Unicode characters",
+		text:     "日本語のテキストとパターン",
+		pattern:  "テキスト",
+		expected: []int{4},
+	},
+	{
+		name:     "Overlapping patterns",
+		text:     "aaaaa",
+		pattern:  "aaa",
+		expected: []int{0, 1, 2},
+	},
+	{
+		name:     "Mismatch on absent character",
+		text:     "abxabcabyzabcab",
+		pattern:  "abcab",
+		expected: []int{3, 10},
+	},
+	{
+		name:     "Absent character before match",
+		text:     "zzzzzzzzcab",
+		pattern:  "cab",
+		expected: []int{8},
+	},
+}
+
 // TestBoyerMoorSearch runs a set of table-driven tests for BoyerMooreSearch.
 // It verifies the function correctly finds all occurrences of a pattern
 // within a given text string. Test cases include edge conditions and
 // typical usage scenarios, checking returned indices against expected results.
 func TestBoyerMoorSearch(test *testing.T) {
+	for _, specificTest := range searchTests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []int = BoyerMooreSearch(specificTest.text, specificTest.pattern)
+
+			if !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("BoyerMooreSearch(%q, %q) = %v; want %v", specificTest.text, specificTest.pattern,
+					result, specificTest.expected)
+			}
+		})
+	}
+}
+
+// TestHorspoolSearch checks that HorspoolSearch returns the same indices as BoyerMooreSearch
+// for every shared table-driven case.
+func TestHorspoolSearch(test *testing.T) {
+	for _, specificTest := range searchTests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []int = HorspoolSearch(specificTest.text, specificTest.pattern)
+			var expected []int = BoyerMooreSearch(specificTest.text, specificTest.pattern)
+
+			if !equalIntSlices(result, expected) || !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("HorspoolSearch(%q, %q) = %v; want %v", specificTest.text, specificTest.pattern,
+					result, expected)
+			}
+		})
+	}
+}
+
+// TestBadCharacterShiftAbsentCharacter checks that a rune missing from the pattern shifts the
+// pattern entirely past it, while a present rune aligns with its last occurrence.
+func TestBadCharacterShiftAbsentCharacter(test *testing.T) {
+	var table map[rune]int = preprocessBadCharacterTable([]rune("abcab"))
+
+	var tests = []struct {
+		name         string
+		character    rune
+		patternIndex int
+		expected     int
+	}{
+		{name: "Absent at last position", character: 'z', patternIndex: 4, expected: 5},
+		{name: "Absent at first position", character: 'z', patternIndex: 0, expected: 1},
+		{name: "Present to the left", character: 'c', patternIndex: 4, expected: 2},
+		{name: "Present only to the right", character: 'b', patternIndex: 2, expected: 1},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var shift int = computeBadCharacterShift(table, specificTest.character, specificTest.patternIndex)
+
+			if shift != specificTest.expected {
+				individualTest.Errorf("computeBadCharacterShift(%q, %d) = %d; want %d", specificTest.character,
+					specificTest.patternIndex, shift, specificTest.expected)
+			}
+		})
+	}
+}
+
+// TestBoyerMooreSearchNonOverlapping compares overlapping and non-overlapping results on
+// repeated-character inputs and checks that non-overlapping matches never overlap.
+func TestBoyerMooreSearchNonOverlapping(test *testing.T) {
+	var tests = []struct {
+		name        string
+		text        string
+		pattern     string
+		overlapping []int
+		expected    []int
+	}{
+		{
+			name:        "Only one fits",
+			text:        "aaaaa",
+			pattern:     "aaa",
+			overlapping: []int{0, 1, 2},
+			expected:    []int{0},
+		},
+		{
+			name:        "Second fits after the first",
+			text:        "aaaaaaa",
+			pattern:     "aaa",
+			overlapping: []int{0, 1, 2, 3, 4},
+			expected:    []int{0, 3},
+		},
+		{
+			name:        "Periodic pattern",
+			text:        "abababab",
+			pattern:     "abab",
+			overlapping: []int{0, 2, 4},
+			expected:    []int{0, 4},
+		},
+		{
+			name:        "Disjoint matches unchanged",
+			text:        "abracadabra",
+			pattern:     "abra",
+			overlapping: []int{0, 7},
+			expected:    []int{0, 7},
+		},
+		{
+			name:        "Empty pattern",
+			text:        "aaaa",
+			pattern:     "",
+			overlapping: []int{},
+			expected:    []int{},
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var overlapping []int = BoyerMooreSearch(specificTest.text, specificTest.pattern)
+			var result []int = BoyerMooreSearchNonOverlapping(specificTest.text, specificTest.pattern)
+
+			if !equalIntSlices(overlapping, specificTest.overlapping) {
+				individualTest.Errorf("BoyerMooreSearch(%q, %q) = %v; want %v", specificTest.text, specificTest.pattern,
+					overlapping, specificTest.overlapping)
+			}
+
+			if !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("BoyerMooreSearchNonOverlapping(%q, %q) = %v; want %v", specificTest.text,
+					specificTest.pattern, result, specificTest.expected)
+			}
+
+			for index := 1; index < len(result); index++ {
+				if result[index]-result[index-1] < len([]rune(specificTest.pattern)) {
+					individualTest.Errorf("Matches at %d and %d overlap", result[index-1], result[index])
+				}
+			}
+		})
+	}
+}
+
+// TestBoyerMooreReplaceAll runs table-driven tests for BoyerMooreReplaceAll, comparing against
+// strings.ReplaceAll where both are defined.
+func TestBoyerMooreReplaceAll(test *testing.T) {
+	var tests = []struct {
+		name        string
+		text        string
+		pattern     string
+		replacement string
+		expected    string
+	}{
+		{
+			name:        "Multiple replacements",
+			text:        "abracadabra",
+			pattern:     "abra",
+			replacement: "ABRA",
+			expected:    "ABRAcadABRA",
+		},
+		{
+			name:        "Shorter replacement",
+			text:        "one, two, three",
+			pattern:     ", ",
+			replacement: ",",
+			expected:    "one,two,three",
+		},
+		{
+			name:        "Longer replacement",
+			text:        "a-b-c",
+			pattern:     "-",
+			replacement: " <-> ",
+			expected:    "a <-> b <-> c",
+		},
+		{
+			name:        "Non-overlapping occurrences",
+			text:        "aaaaa",
+			pattern:     "aa",
+			replacement: "b",
+			expected:    "bba",
+		},
+		{
+			name:        "Deleting matches",
+			text:        "日本語のテキスト",
+			pattern:     "の",
+			replacement: "",
+			expected:    "日本語テキスト",
+		},
+		{
+			name:        "No match",
+			text:        "hello world",
+			pattern:     "xyz",
+			replacement: "!",
+			expected:    "hello world",
+		},
+		{
+			name:        "Empty pattern",
+			text:        "unchanged",
+			pattern:     "",
+			replacement: "x",
+			expected:    "unchanged",
+		},
+		{
+			name:        "Invalid UTF-8 between matches",
+			text:        "\xffab\xfeab\xfd",
+			pattern:     "ab",
+			replacement: "--",
+			expected:    "\xff--\xfe--\xfd",
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result string = BoyerMooreReplaceAll(specificTest.text, specificTest.pattern, specificTest.replacement)
+
+			if result != specificTest.expected {
+				individualTest.Errorf("BoyerMooreReplaceAll(%q, %q, %q) = %q; want %q", specificTest.text,
+					specificTest.pattern, specificTest.replacement, result, specificTest.expected)
+			}
+
+			if specificTest.pattern != "" && result != strings.ReplaceAll(specificTest.text, specificTest.pattern, specificTest.replacement) {
+				individualTest.Errorf("BoyerMooreReplaceAll disagrees with strings.ReplaceAll: %q", result)
+			}
+		})
+	}
+}
+
+// TestBoyerMooreCount checks that BoyerMooreCount agrees with the number of indices returned
+// by BoyerMooreSearch for every shared table-driven case.
+func TestBoyerMooreCount(test *testing.T) {
+	for _, specificTest := range searchTests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var count int = BoyerMooreCount(specificTest.text, specificTest.pattern)
+			var expected int = len(BoyerMooreSearch(specificTest.text, specificTest.pattern))
+
+			if count != expected || count != len(specificTest.expected) {
+				individualTest.Errorf("BoyerMooreCount(%q, %q) = %d; want %d", specificTest.text, specificTest.pattern,
+					count, expected)
+			}
+		})
+	}
+}
+
+// TestBoyerMooreIndex runs table-driven tests for BoyerMooreIndex, including the convention
+// that an empty pattern is found at index 0.
+func TestBoyerMooreIndex(test *testing.T) {
 	var tests = []struct {
 		name     string
 		text     string
 		pattern  string
-		expected []int
+		expected int
 	}{
 		{
-			name:     "Example test",
-			text:     "hello",
-			pattern:  "ll",
-			expected: []int{2},
+			name:     "Match at start",
+			text:     "hello world",
+			pattern:  "hello",
+			expected: 0,
+		},
+		{
+			name:     "Match in middle",
+			text:     "abracadabra",
+			pattern:  "cad",
+			expected: 4,
 		},
 		{
-			name:     "Exact match in middle",
-			text:     "say hello to the world",
-			pattern:  "hello",
-			expected: []int{4},
+			name:     "Match at end",
+			text:     "abracadabra",
+			pattern:  "dabra",
+			expected: 6,
 		},
 		{
-			name:     "Multiple occurrences",
+			name:     "First of several matches",
 			text:     "abracadabra",
 			pattern:  "abra",
-			expected: []int{0, 7},
+			expected: 0,
 		},
 		{
 			name:     "No match",
 			text:     "abcdefg",
 			pattern:  "xyz",
-			expected: []int{},
+			expected: -1,
 		},
 		{
-			name:     "Pattern equals text",
-			text:     "pattern",
-			pattern:  "pattern",
-			expected: []int{0},
+			name:     "Pattern longer than text",
+			text:     "short",
+			pattern:  "longpattern",
+			expected: -1,
 		},
 		{
 			name:     "Empty pattern",
 			text:     "nonempty",
 			pattern:  "",
-			expected: []int{},
+			expected: 0,
+		},
+		{
+			name:     "Unicode characters",
+			text:     "日本語のテキストとパターン",
+			pattern:  "パターン",
+			expected: 9,
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result int = BoyerMooreIndex(specificTest.text, specificTest.pattern)
+
+			if result != specificTest.expected {
+				individualTest.Errorf("BoyerMooreIndex(%q, %q) = %d; want %d", specificTest.text, specificTest.pattern,
+					result, specificTest.expected)
+			}
+		})
+	}
+}
+
+// TestBoyerMooreSearchFrom runs table-driven tests for BoyerMooreSearchFrom, checking that
+// matches before the offset are excluded and out-of-range offsets are handled.
+func TestBoyerMooreSearchFrom(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		pattern  string
+		start    int
+		expected []int
+	}{
+		{
+			name:     "From the beginning",
+			text:     "abracadabra",
+			pattern:  "abra",
+			start:    0,
+			expected: []int{0, 7},
+		},
+		{
+			name:     "Earlier match excluded",
+			text:     "abracadabra",
+			pattern:  "abra",
+			start:    1,
+			expected: []int{7},
+		},
+		{
+			name:     "Match exactly at start",
+			text:     "abracadabra",
+			pattern:  "abra",
+			start:    7,
+			expected: []int{7},
+		},
+		{
+			name:     "Overlapping matches after start",
+			text:     "aaaaa",
+			pattern:  "aa",
+			start:    2,
+			expected: []int{2, 3},
+		},
+		{
+			name:     "Negative start clamped",
+			text:     "abracadabra",
+			pattern:  "abra",
+			start:    -5,
+			expected: []int{0, 7},
+		},
+		{
+			name:     "Start past the end",
+			text:     "abracadabra",
+			pattern:  "a",
+			start:    100,
+			expected: nil,
+		},
+		{
+			name:     "Rune offsets",
+			text:     "日本日本日本",
+			pattern:  "日本",
+			start:    1,
+			expected: []int{2, 4},
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []int = BoyerMooreSearchFrom(specificTest.text, specificTest.pattern, specificTest.start)
+
+			if !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("BoyerMooreSearchFrom(%q, %q, %d) = %v; want %v", specificTest.text,
+					specificTest.pattern, specificTest.start, result, specificTest.expected)
+			}
+		})
+	}
+}
+
+// TestBoyerMooreContains runs table-driven tests for BoyerMooreContains and checks that it
+// agrees with strings.Contains, including for empty patterns.
+func TestBoyerMooreContains(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		pattern  string
+		expected bool
+	}{
+		{
+			name:     "Present",
+			text:     "needle in a haystack",
+			pattern:  "hay",
+			expected: true,
+		},
+		{
+			name:     "Absent",
+			text:     "needle in a haystack",
+			pattern:  "straw",
+			expected: false,
 		},
 		{
 			name:     "Pattern longer than text",
 			text:     "short",
 			pattern:  "longpattern",
-			expected: []int{},
+			expected: false,
+		},
+		{
+			name:     "Empty pattern",
+			text:     "anything",
+			pattern:  "",
+			expected: true,
+		},
+		{
+			name:     "Empty pattern and text",
+			text:     "",
+			pattern:  "",
+			expected: true,
 		},
 		{
 			name:     "Unicode characters",
-			text:     "日本語のテキストとパターン",
-			pattern:  "テキスト",
-			expected: []int{4},
+			text:     "日本語のテキスト",
+			pattern:  "テキ",
+			expected: true,
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result bool = BoyerMooreContains(specificTest.text, specificTest.pattern)
+
+			if result != specificTest.expected || result != strings.Contains(specificTest.text, specificTest.pattern) {
+				individualTest.Errorf("BoyerMooreContains(%q, %q) = %v; want %v", specificTest.text, specificTest.pattern,
+					result, specificTest.expected)
+			}
+		})
+	}
+}
+
+// TestBoyerMooreSearchWholeWord runs table-driven tests for BoyerMooreSearchWholeWord, checking
+// that occurrences inside longer words are excluded.
+func TestBoyerMooreSearchWholeWord(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		pattern  string
+		expected []int
+	}{
+		{
+			name:     "Word between spaces",
+			text:     "let's go now",
+			pattern:  "go",
+			expected: []int{6},
 		},
 		{
-			name:     "Overlapping patterns",
-			text:     "aaaaa",
-			pattern:  "aaa",
-			expected: []int{0, 1, 2},
+			name:     "Only inside a word",
+			text:     "googol",
+			pattern:  "go",
+			expected: nil,
+		},
+		{
+			name:     "Text edges and punctuation",
+			text:     "go, google, go!",
+			pattern:  "go",
+			expected: []int{0, 12},
+		},
+		{
+			name:     "Digits are word runes",
+			text:     "go2 2go go",
+			pattern:  "go",
+			expected: []int{8},
+		},
+		{
+			name:     "Unicode letters are word runes",
+			text:     "écho echo",
+			pattern:  "cho",
+			expected: nil,
+		},
+		{
+			name:     "Whole text",
+			text:     "日本",
+			pattern:  "日本",
+			expected: []int{0},
+		},
+		{
+			name:     "Empty pattern",
+			text:     "go",
+			pattern:  "",
+			expected: nil,
 		},
 	}
 
 	for _, specificTest := range tests {
 		test.Run(specificTest.name, func(individualTest *testing.T) {
-			var result []int = BoyerMooreSearch(specificTest.text, specificTest.pattern)
+			var result []int = BoyerMooreSearchWholeWord(specificTest.text, specificTest.pattern)
 
 			if !equalIntSlices(result, specificTest.expected) {
-				individualTest.Errorf("BoyerMooreSearch(%q, %q) = %v; want %v", specificTest.text, specificTest.pattern,
-					result, specificTest.expected)
+				individualTest.Errorf("BoyerMooreSearchWholeWord(%q, %q) = %v; want %v", specificTest.text,
+					specificTest.pattern, result, specificTest.expected)
 			}
 		})
 	}
 }
+
+// TestBoyerMooreSearchContext checks the snippets around each match, including clamping at the
+// edges of the text and cutting multi-byte text on rune boundaries.
+func TestBoyerMooreSearchContext(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		pattern  string
+		radius   int
+		expected []ContextMatch
+	}{
+		{
+			name:     "Context on both sides",
+			text:     "the quick brown fox",
+			pattern:  "quick",
+			radius:   2,
+			expected: []ContextMatch{{Index: 4, Snippet: "e quick b"}},
+		},
+		{
+			name:     "Clamped at the start",
+			text:     "go home",
+			pattern:  "go",
+			radius:   5,
+			expected: []ContextMatch{{Index: 0, Snippet: "go home"}},
+		},
+		{
+			name:     "Clamped at the end",
+			text:     "let's go",
+			pattern:  "go",
+			radius:   3,
+			expected: []ContextMatch{{Index: 6, Snippet: "'s go"}},
+		},
+		{
+			name:    "Multiple matches",
+			text:    "abracadabra",
+			pattern: "abra",
+			radius:  1,
+			expected: []ContextMatch{
+				{Index: 0, Snippet: "abrac"},
+				{Index: 7, Snippet: "dabra"},
+			},
+		},
+		{
+			name:     "Multi-byte runes are not split",
+			text:     "日本語のテキストとパターン",
+			pattern:  "テキスト",
+			radius:   2,
+			expected: []ContextMatch{{Index: 4, Snippet: "語のテキストとパ"}},
+		},
+		{
+			name:     "Zero radius",
+			text:     "héllo wörld",
+			pattern:  "wörld",
+			radius:   0,
+			expected: []ContextMatch{{Index: 6, Snippet: "wörld"}},
+		},
+		{
+			name:     "Negative radius",
+			text:     "abc",
+			pattern:  "b",
+			radius:   -3,
+			expected: []ContextMatch{{Index: 1, Snippet: "b"}},
+		},
+		{
+			name:     "No match",
+			text:     "abc",
+			pattern:  "xyz",
+			radius:   2,
+			expected: nil,
+		},
+		{
+			name:     "Empty pattern",
+			text:     "abc",
+			pattern:  "",
+			radius:   2,
+			expected: nil,
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []ContextMatch = BoyerMooreSearchContext(specificTest.text, specificTest.pattern, specificTest.radius)
+
+			if len(result) != len(specificTest.expected) {
+				individualTest.Fatalf("BoyerMooreSearchContext(%q, %q, %d) = %v; want %v", specificTest.text,
+					specificTest.pattern, specificTest.radius, result, specificTest.expected)
+			}
+
+			for index := range result {
+				if result[index] != specificTest.expected[index] {
+					individualTest.Errorf("match %d = %+v; want %+v", index, result[index], specificTest.expected[index])
+				}
+
+				if !utf8.ValidString(result[index].Snippet) {
+					individualTest.Errorf("match %d snippet %q is not valid UTF-8", index, result[index].Snippet)
+				}
+			}
+		})
+	}
+}
+
+// TestBoyerMooreSearchBytes runs table-driven tests for BoyerMooreSearchBytes, including
+// non-UTF-8 sequences that the rune-based search would decode to U+FFFD.
+func TestBoyerMooreSearchBytes(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     []byte
+		pattern  []byte
+		expected []int
+	}{
+		{
+			name:     "ASCII text",
+			text:     []byte("abracadabra"),
+			pattern:  []byte("abra"),
+			expected: []int{0, 7},
+		},
+		{
+			name:     "Invalid UTF-8 bytes",
+			text:     []byte{0xff, 0xfe, 0x00, 0xff, 0xfd, 0xff, 0xfe},
+			pattern:  []byte{0xff, 0xfe},
+			expected: []int{0, 5},
+		},
+		{
+			name:     "Byte offsets after multi-byte runes",
+			text:     []byte("日本abc"),
+			pattern:  []byte("abc"),
+			expected: []int{6},
+		},
+		{
+			name:     "Partial UTF-8 sequence",
+			text:     []byte("日本"),
+			pattern:  []byte{0xe6, 0x9c},
+			expected: []int{3},
+		},
+		{
+			name:     "Zero bytes",
+			text:     []byte{0x00, 0x00, 0x00, 0x01},
+			pattern:  []byte{0x00, 0x00},
+			expected: []int{0, 1},
+		},
+		{
+			name:     "Empty pattern",
+			text:     []byte{0x01, 0x02},
+			pattern:  []byte{},
+			expected: []int{},
+		},
+		{
+			name:     "Pattern longer than text",
+			text:     []byte{0x01},
+			pattern:  []byte{0x01, 0x02},
+			expected: []int{},
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []int = BoyerMooreSearchBytes(specificTest.text, specificTest.pattern)
+
+			if !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("BoyerMooreSearchBytes(%v, %v) = %v; want %v", specificTest.text, specificTest.pattern,
+					result, specificTest.expected)
+			}
+		})
+	}
+
+	// Distinct invalid bytes all decode to U+FFFD, so the rune-based search reports false matches.
+	var runeResult []int = BoyerMooreSearch(string([]byte{0xfd, 0xfe}), string([]byte{0xff}))
+
+	if len(runeResult) == 0 {
+		test.Errorf("Expected the rune-based search to mangle invalid bytes into matches")
+	}
+
+	if byteResult := BoyerMooreSearchBytes([]byte{0xfd, 0xfe}, []byte{0xff}); len(byteResult) != 0 {
+		test.Errorf("BoyerMooreSearchBytes found %v; want no match for distinct bytes", byteResult)
+	}
+}
+
+// naiveSearch returns every rune index where the pattern occurs, by direct comparison.
+func naiveSearch(text string, pattern string) []int {
+	var textRunes []rune = []rune(text)
+	var patternRunes []rune = []rune(pattern)
+	var indices []int
+
+	for start := 0; len(patternRunes) > 0 && start+len(patternRunes) <= len(textRunes); start++ {
+		if string(textRunes[start:start+len(patternRunes)]) == pattern {
+			indices = append(indices, start)
+		}
+	}
+
+	return indices
+}
+
+// TestGalilRuleMatchesNaiveSearch compares every search mode against a naive search on random
+// texts over a two-letter alphabet, where overlapping and periodic matches are frequent.
+func TestGalilRuleMatchesNaiveSearch(test *testing.T) {
+	var random *rand.Rand = rand.New(rand.NewSource(1))
+
+	var randomString = func(length int) string {
+		var builder strings.Builder
+
+		for index := 0; index < length; index++ {
+			builder.WriteByte("ab"[random.Intn(2)])
+		}
+
+		return builder.String()
+	}
+
+	for iteration := 0; iteration < 2000; iteration++ {
+		var text string = randomString(random.Intn(40))
+		var pattern string = randomString(1 + random.Intn(6))
+		var expected []int = naiveSearch(text, pattern)
+
+		if result := BoyerMooreSearch(text, pattern); !equalIntSlices(result, expected) {
+			test.Fatalf("BoyerMooreSearch(%q, %q) = %v; want %v", text, pattern, result, expected)
+		}
+
+		if result := BoyerMooreSearchBytes([]byte(text), []byte(pattern)); !equalIntSlices(result, expected) {
+			test.Fatalf("BoyerMooreSearchBytes(%q, %q) = %v; want %v", text, pattern, result, expected)
+		}
+
+		if result := BoyerMooreSearchFold(strings.ToUpper(text), pattern); !equalIntSlices(result, expected) {
+			test.Fatalf("BoyerMooreSearchFold(%q, %q) = %v; want %v", strings.ToUpper(text), pattern, result, expected)
+		}
+	}
+}
+
+// TestGalilRuleLinearComparisons checks that searching a run of one character for a shorter
+// run of it takes at most two comparisons per text rune, instead of one per pattern rune per match.
+func TestGalilRuleLinearComparisons(test *testing.T) {
+	var text string = strings.Repeat("a", 10000)
+	var matcher *Matcher = Compile(strings.Repeat("a", 100))
+	var matches int = 0
+
+	var comparisons int = matcher.scanCounting([]rune(text), false, func(index int) bool {
+		matches++
+
+		return true
+	})
+
+	if matches != 9901 {
+		test.Fatalf("scanCounting found %d matches; want 9901", matches)
+	}
+
+	if comparisons > 2*len(text) {
+		test.Errorf("scanCounting made %d comparisons; want at most %d", comparisons, 2*len(text))
+	}
+}
+
+// BenchmarkSearchPeriodicText searches a highly periodic text and reports the comparisons made
+// per search next to the (n - m + 1) * m a search without the Galil rule would need.
+func BenchmarkSearchPeriodicText(benchmark *testing.B) {
+	var text string = strings.Repeat("a", 100000)
+	var pattern string = strings.Repeat("a", 1000)
+
+	var matcher *Matcher = Compile(pattern)
+	var textRunes []rune = []rune(text)
+	var comparisons int = 0
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		comparisons += matcher.scanCounting(textRunes, false, func(index int) bool { return true })
+	}
+
+	benchmark.ReportMetric(float64(comparisons)/float64(benchmark.N), "comparisons/op")
+	benchmark.ReportMetric(float64((len(text)-len(pattern)+1)*len(pattern)), "naive-comparisons/op")
+}
+
+// TestBoyerMooreSearchApproximate runs table-driven tests for BoyerMooreSearchApproximate.
+func TestBoyerMooreSearchApproximate(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		pattern  string
+		k        int
+		expected []int
+	}{
+		{
+			name:     "Last rune differs",
+			text:     "abx",
+			pattern:  "abc",
+			k:        1,
+			expected: []int{0},
+		},
+		{
+			name:     "Middle rune differs",
+			text:     "aXc",
+			pattern:  "abc",
+			k:        1,
+			expected: []int{0},
+		},
+		{
+			name:     "Two differences exceed k",
+			text:     "aXY",
+			pattern:  "abc",
+			k:        1,
+			expected: []int{},
+		},
+		{
+			name:     "Exact and approximate occurrences",
+			text:     "xxabcxxabdxxzbc",
+			pattern:  "abc",
+			k:        1,
+			expected: []int{2, 7, 12},
+		},
+		{
+			name:     "Zero mismatches is exact search",
+			text:     "abracadabra",
+			pattern:  "abra",
+			k:        0,
+			expected: []int{0, 7},
+		},
+		{
+			name:     "Budget covers the whole pattern",
+			text:     "wxyz",
+			pattern:  "ab",
+			k:        2,
+			expected: []int{0, 1, 2},
+		},
+		{
+			name:     "Negative k",
+			text:     "abc",
+			pattern:  "abc",
+			k:        -1,
+			expected: []int{},
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []int = BoyerMooreSearchApproximate(specificTest.text, specificTest.pattern, specificTest.k)
+
+			if !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("BoyerMooreSearchApproximate(%q, %q, %d) = %v; want %v", specificTest.text,
+					specificTest.pattern, specificTest.k, result, specificTest.expected)
+			}
+		})
+	}
+}
+
+// naiveApproximateSearch returns every rune index where the pattern aligns with at most k mismatches.
+func naiveApproximateSearch(text string, pattern string, k int) []int {
+	var textRunes []rune = []rune(text)
+	var patternRunes []rune = []rune(pattern)
+	var indices []int
+
+	for start := 0; len(patternRunes) > 0 && start+len(patternRunes) <= len(textRunes); start++ {
+		var mismatches int = 0
+
+		for index, character := range patternRunes {
+			if textRunes[start+index] != character {
+				mismatches++
+			}
+		}
+
+		if mismatches <= k {
+			indices = append(indices, start)
+		}
+	}
+
+	return indices
+}
+
+// TestBoyerMooreSearchApproximateMatchesNaive checks the skipping logic against a naive search on
+// random texts over a three-letter alphabet.
+func TestBoyerMooreSearchApproximateMatchesNaive(test *testing.T) {
+	var random *rand.Rand = rand.New(rand.NewSource(2))
+
+	var randomString = func(length int) string {
+		var builder strings.Builder
+
+		for index := 0; index < length; index++ {
+			builder.WriteByte("abc"[random.Intn(3)])
+		}
+
+		return builder.String()
+	}
+
+	for iteration := 0; iteration < 3000; iteration++ {
+		var text string = randomString(random.Intn(40))
+		var pattern string = randomString(1 + random.Intn(7))
+		var k int = random.Intn(4)
+
+		var expected []int = naiveApproximateSearch(text, pattern, k)
+
+		if result := BoyerMooreSearchApproximate(text, pattern, k); !equalIntSlices(result, expected) {
+			test.Fatalf("BoyerMooreSearchApproximate(%q, %q, %d) = %v; want %v", text, pattern, k, result, expected)
+		}
+	}
+}
+
+// TestBoyerMooreSearchFold runs table-driven tests for BoyerMooreSearchFold, covering
+// ASCII case differences and runes whose folded forms differ in byte length.
+func TestBoyerMooreSearchFold(test *testing.T) {
+	var tests = []struct {
+		name     string
+		text     string
+		pattern  string
+		expected []int
+	}{
+		{
+			name:     "Mixed case occurrences",
+			text:     "say HELLO to hello",
+			pattern:  "Hello",
+			expected: []int{4, 13},
+		},
+		{
+			name:     "No match",
+			text:     "Hello World",
+			pattern:  "planet",
+			expected: []int{},
+		},
+		{
+			name:     "Empty pattern",
+			text:     "nonempty",
+			pattern:  "",
+			expected: []int{},
+		},
+		{
+			name:     "Kelvin sign folds to k",
+			text:     "1\u212A2k",
+			pattern:  "K",
+			expected: []int{1, 3},
+		},
+		{
+			name:     "Greek sigma forms",
+			text:     "ΟΔΥΣΣΕΥΣ οδυσσευς",
+			pattern:  "σσευς",
+			expected: []int{3, 12},
+		},
+		{
+			name:     "Indices after multi-byte runes",
+			text:     "日本 ABC abc",
+			pattern:  "aBc",
+			expected: []int{3, 7},
+		},
+		{
+			name:     "Overlapping patterns",
+			text:     "aAaAa",
+			pattern:  "aaa",
+			expected: []int{0, 1, 2},
+		},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var result []int = BoyerMooreSearchFold(specificTest.text, specificTest.pattern)
+
+			if !equalIntSlices(result, specificTest.expected) {
+				individualTest.Errorf("BoyerMooreSearchFold(%q, %q) = %v; want %v", specificTest.text, specificTest.pattern,
+					result, specificTest.expected)
+			}
+		})
+	}
+}
+
+// TestMatcherReuse checks that one compiled Matcher gives the same results as BoyerMooreSearch
+// and BoyerMooreSearchFold on several texts.
+func TestMatcherReuse(test *testing.T) {
+	var texts []string = []string{"abracadabra", "ABRACADABRA", "cadabra", "", "abra abra"}
+
+	var matcher *Matcher = Compile("abra")
+	var foldMatcher *Matcher = CompileFold("ABRA")
+
+	for _, text := range texts {
+		if result, expected := matcher.Search(text), BoyerMooreSearch(text, "abra"); !equalIntSlices(result, expected) {
+			test.Errorf("Compile(%q).Search(%q) = %v; want %v", "abra", text, result, expected)
+		}
+
+		if result, expected := foldMatcher.Search(text), BoyerMooreSearchFold(text, "ABRA"); !equalIntSlices(result, expected) {
+			test.Errorf("CompileFold(%q).Search(%q) = %v; want %v", "ABRA", text, result, expected)
+		}
+	}
+
+	if result := Compile("").Search("text"); len(result) != 0 {
+		test.Errorf("Compile(%q).Search(%q) = %v; want []", "", "text", result)
+	}
+}
+
+// TestMatcherIterator checks that the iterator yields the same indices as Search, in order,
+// and keeps reporting exhaustion after the last match.
+func TestMatcherIterator(test *testing.T) {
+	var tests = []struct {
+		name    string
+		text    string
+		pattern string
+		fold    bool
+	}{
+		{name: "Multiple matches", text: "abracadabra", pattern: "abra"},
+		{name: "Overlapping matches", text: "aaaaaa", pattern: "aaa"},
+		{name: "No match", text: "hello world", pattern: "xyz"},
+		{name: "Pattern longer than text", text: "ab", pattern: "abc"},
+		{name: "Empty pattern", text: "abc", pattern: ""},
+		{name: "Empty text", text: "", pattern: "a"},
+		{name: "Unicode text", text: "日本語の日本語", pattern: "日本"},
+		{name: "Match at end", text: "xxxab", pattern: "ab"},
+		{name: "Case folding", text: "Go go GO", pattern: "go", fold: true},
+	}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var matcher *Matcher = Compile(specificTest.pattern)
+
+			if specificTest.fold {
+				matcher = CompileFold(specificTest.pattern)
+			}
+
+			var expected []int = matcher.Search(specificTest.text)
+			var next func() (int, bool) = matcher.Iterator(specificTest.text)
+			var result []int
+
+			for index, ok := next(); ok; index, ok = next() {
+				result = append(result, index)
+			}
+
+			if !equalIntSlices(result, expected) {
+				individualTest.Errorf("Iterator(%q) yielded %v; want %v", specificTest.text, result, expected)
+			}
+
+			for call := 0; call < 2; call++ {
+				if index, ok := next(); ok || index != -1 {
+					individualTest.Errorf("exhausted iterator returned (%d, %v); want (-1, false)", index, ok)
+				}
+			}
+		})
+	}
+}
+
+// TestMatcherIteratorStopsEarly checks that a caller can stop after the first few matches and
+// that the matches pulled so far are the leading ones reported by Search.
+func TestMatcherIteratorStopsEarly(test *testing.T) {
+	var text string = strings.Repeat("ab", 100)
+	var matcher *Matcher = Compile("ab")
+	var next func() (int, bool) = matcher.Iterator(text)
+	var result []int
+
+	for len(result) < 10 {
+		index, ok := next()
+
+		if !ok {
+			break
+		}
+
+		result = append(result, index)
+	}
+
+	if expected := matcher.Search(text)[:10]; !equalIntSlices(result, expected) {
+		test.Errorf("first 10 iterator matches = %v; want %v", result, expected)
+	}
+}
+
+// searchReaderOffsets collects every offset SearchReader emits for the reader.
+func searchReaderOffsets(matcher *Matcher, reader io.Reader) ([]int, error) {
+	var offsets []int
+
+	var err error = matcher.SearchReader(reader, func(offset int) {
+		offsets = append(offsets, offset)
+	})
+
+	return offsets, err
+}
+
+// TestSearchReaderMatchesSearch feeds long texts through one-byte and half-buffer readers and
+// checks that the streamed offsets equal the in-memory results, with no duplicates at boundaries.
+func TestSearchReaderMatchesSearch(test *testing.T) {
+	var tests = []struct {
+		name    string
+		text    string
+		matcher *Matcher
+	}{
+		{
+			name:    "Matches spanning windows",
+			text:    strings.Repeat("xyzab", 3000),
+			matcher: Compile("abxyz"),
+		},
+		{
+			name:    "Overlapping matches",
+			text:    strings.Repeat("a", 10000),
+			matcher: Compile("aaa"),
+		},
+		{
+			name:    "Multi-byte runes folded",
+			text:    strings.Repeat("日本 Σοφία ", 1500),
+			matcher: CompileFold("ΣΟΦΊΑ 日"),
+		},
+		{
+			name:    "Text shorter than pattern",
+			text:    "ab",
+			matcher: Compile("abc"),
+		},
+	}
+
+	var readers []func(io.Reader) io.Reader = []func(io.Reader) io.Reader{iotest.OneByteReader, iotest.HalfReader}
+
+	for _, specificTest := range tests {
+		test.Run(specificTest.name, func(individualTest *testing.T) {
+			var expected []int = specificTest.matcher.Search(specificTest.text)
+
+			for _, wrap := range readers {
+				offsets, err := searchReaderOffsets(specificTest.matcher, wrap(strings.NewReader(specificTest.text)))
+
+				if err != nil {
+					individualTest.Fatalf("SearchReader returned %v", err)
+				}
+
+				if !equalIntSlices(offsets, expected) {
+					individualTest.Errorf("SearchReader found %d offsets; want the %d found by Search", len(offsets), len(expected))
+				}
+			}
+		})
+	}
+}
+
+// TestSearchReaderReportsError ensures a read error is returned after the matches in the
+// runes read before it have been emitted.
+func TestSearchReaderReportsError(test *testing.T) {
+	var failure error = errors.New("disk failure")
+
+	offsets, err := searchReaderOffsets(Compile("ab"), io.MultiReader(strings.NewReader("abab"), iotest.ErrReader(failure)))
+
+	if !errors.Is(err, failure) {
+		test.Errorf("SearchReader error = %v; want %v", err, failure)
+	}
+
+	if !equalIntSlices(offsets, []int{0, 2}) {
+		test.Errorf("SearchReader offsets = %v; want [0 2]", offsets)
+	}
+}
+
+// benchmarkTexts builds 1000 short texts that each contain the benchmark pattern once.
+func benchmarkTexts() []string {
+	var texts []string = make([]string, 1000)
+
+	for index := range texts {
+		texts[index] = fmt.Sprintf("log line %d: request handled by worker-%d in %dms", index, index%16, index%250)
+	}
+
+	return texts
+}
+
+// BenchmarkBoyerMooreSearchPerText rebuilds the tables for every one of the 1000 texts.
+func BenchmarkBoyerMooreSearchPerText(benchmark *testing.B) {
+	var texts []string = benchmarkTexts()
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		for _, text := range texts {
+			BoyerMooreSearch(text, "handled by worker")
+		}
+	}
+}
+
+// BenchmarkMatcherReuse compiles the pattern once and reuses it for all 1000 texts.
+func BenchmarkMatcherReuse(benchmark *testing.B) {
+	var texts []string = benchmarkTexts()
+
+	for iteration := 0; iteration < benchmark.N; iteration++ {
+		var matcher *Matcher = Compile("handled by worker")
+
+		for _, text := range texts {
+			matcher.Search(text)
+		}
+	}
+}
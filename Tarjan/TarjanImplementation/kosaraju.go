@@ -0,0 +1,109 @@
+// ===================================================================================
+// File:        kosaraju.go
+// Package:     tarjan
+// Description: This file implements Kosaraju's algorithm as a second, independent way
+//
+//	to identify strongly connected components (SCCs) in a directed graph. It is
+//	used to cross-validate the results of Tarjan's algorithm and also runs in
+//	O(V + E) time complexity.
+//
+//	Features implemented in this file:
+//	- First DFS pass recording vertices in order of finishing time
+//	- Transpose helper that reverses every edge while preserving all vertices
+//	- Second DFS pass on the transposed graph in reverse finishing order
+//	- Returns a slice of SCCs, where each SCC is a slice of vertex IDs
+//
+// Author:      Braiden Gole
+// Created:     July 20, 2025
+//
+// ===================================================================================
+package tarjanimplementation
+
+import "sort"
+
+// Transpose returns a new graph with every edge reversed. Every vertex of the input, including isolated vertices
+// and neighbors that are never declared as keys, appears as a key in the output. Vertices are visited in ascending
+// order so each reversed adjacency list is sorted.
+func Transpose(graph map[int][]int) map[int][]int {
+	var transposed map[int][]int = make(map[int][]int)
+	var vertices []int = make([]int, 0, len(graph))
+
+	for vertex := range graph {
+		vertices = append(vertices, vertex)
+	}
+
+	sort.Ints(vertices)
+
+	for _, vertex := range vertices {
+		if _, exists := transposed[vertex]; !exists {
+			transposed[vertex] = []int{}
+		}
+
+		for _, neighbor := range graph[vertex] {
+			transposed[neighbor] = append(transposed[neighbor], vertex)
+		}
+	}
+
+	return transposed
+}
+
+// KosarajuStronglyConnectedComponents executes Kosaraju's two-pass algorithm and returns a slice of strongly
+// connected components. Vertices that only appear as edge targets are treated as vertices of the graph.
+func KosarajuStronglyConnectedComponents(graph map[int][]int) [][]int {
+	var visited map[int]bool = make(map[int]bool)
+	var finishOrder []int = []int{}
+
+	// First pass: record each vertex once all of its descendants have finished.
+	var visit func(vertex int)
+
+	visit = func(vertex int) {
+		visited[vertex] = true
+
+		for _, neighbor := range graph[vertex] {
+			if !visited[neighbor] {
+				visit(neighbor)
+			}
+		}
+
+		finishOrder = append(finishOrder, vertex)
+	}
+
+	for vertex := range graph {
+		if !visited[vertex] {
+			visit(vertex)
+		}
+	}
+
+	// Reverse every edge to build the transposed graph.
+	var transposed map[int][]int = Transpose(graph)
+
+	// Second pass: each DFS tree on the transposed graph, started in reverse finishing order, is one SCC.
+	var assigned map[int]bool = make(map[int]bool)
+	var components [][]int = [][]int{}
+	var component []int
+
+	var collect func(vertex int)
+
+	collect = func(vertex int) {
+		assigned[vertex] = true
+		component = append(component, vertex)
+
+		for _, neighbor := range transposed[vertex] {
+			if !assigned[neighbor] {
+				collect(neighbor)
+			}
+		}
+	}
+
+	for index := len(finishOrder) - 1; index >= 0; index-- {
+		if assigned[finishOrder[index]] {
+			continue
+		}
+
+		component = []int{}
+		collect(finishOrder[index])
+		components = append(components, component)
+	}
+
+	return components
+}
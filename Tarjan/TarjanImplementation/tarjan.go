@@ -10,8 +10,14 @@
 //	Features implemented in this package:
 //	- TarjanSCCFinder struct to encapsulate internal state
 //	- Recursive depth-first search and low-link comparison
+//	- Optional maximum recursion depth, beyond which the search continues
+//	  iteratively with an explicit stack to avoid stack overflow
+//	- Optional callback fired as each component is finalized
 //	- Stack tracking to manage component membership
 //	- Returns a slice of SCCs, where each SCC is a slice of vertex IDs
+//	- Extracts one concrete closed walk per non-trivial SCC for display
+//	- Histogram of component sizes to characterize the graph's structure
+//	- Builds the adjacency map directly from a list of directed edges
 //
 // Author:      Braiden Gole
 // Created:     July 20, 2025
@@ -20,7 +26,19 @@
 package tarjanimplementation
 
 // Tarjan strongly connected component holds the internal state used during SCC detection.
+//
+// OnComponent, if set, is called with each strongly connected component as soon as it is
+// finalized, before FindStronglyConnectedComponents returns, so progress can be reported on huge
+// graphs. The slice it receives is the one stored in the result and must not be modified.
+//
+// MaxRecursionDepth, if positive, bounds the depth of the recursive search. A vertex that would
+// be explored deeper than that is handed to an iterative search with an explicit stack, which
+// shares the same state and finds the same components without growing the goroutine stack.
+// Zero or a negative value leaves the recursion unbounded.
 type TarjanStronglyConnectedComponent struct {
+	OnComponent       func(component []int)
+	MaxRecursionDepth int
+
 	graph                       map[int][]int
 	index                       int
 	nodeIndex                   map[int]int
@@ -43,9 +61,28 @@ func NewTarjanStronglyConnectedComponent(graph map[int][]int) *TarjanStronglyCon
 	}
 }
 
+// NewFromEdges initializes a new TarjanStronglyConnectedComponent from a slice of directed edges, where each
+// edge is a [from, to] pair. Both endpoints are registered as vertices, so a vertex that only receives edges still
+// forms its own component.
+func NewFromEdges(edges [][2]int) *TarjanStronglyConnectedComponent {
+	var graph map[int][]int = make(map[int][]int)
+
+	for _, edge := range edges {
+		graph[edge[0]] = append(graph[edge[0]], edge[1])
+
+		// Register the target without adding an edge if it has not been seen yet.
+		if _, exists := graph[edge[1]]; !exists {
+			graph[edge[1]] = []int{}
+		}
+	}
+
+	return NewTarjanStronglyConnectedComponent(graph)
+}
+
 // strongConnect is a recursive helper that performs the DFS and identifies strongly connected components based on index and
-// low-link comparisons.
-func (tarjan *TarjanStronglyConnectedComponent) strongConnect(vertex int) {
+// low-link comparisons. Depth is the number of enclosing strongConnect calls; once a child call would reach MaxRecursionDepth,
+// unvisited neighbors are explored by strongConnectIterative instead.
+func (tarjan *TarjanStronglyConnectedComponent) strongConnect(vertex int, depth int) {
 	// Assign discovery index and low-link value to the current vertex.
 	tarjan.nodeIndex[vertex] = tarjan.index
 	tarjan.lowLinkValue[vertex] = tarjan.index
@@ -59,7 +96,11 @@ func (tarjan *TarjanStronglyConnectedComponent) strongConnect(vertex int) {
 	for _, neighbor := range tarjan.graph[vertex] {
 		// If the neighbor has not been visited, recurse on it.
 		if _, visited := tarjan.nodeIndex[neighbor]; !visited {
-			tarjan.strongConnect(neighbor)
+			if tarjan.MaxRecursionDepth > 0 && depth+1 >= tarjan.MaxRecursionDepth {
+				tarjan.strongConnectIterative(neighbor)
+			} else {
+				tarjan.strongConnect(neighbor, depth+1)
+			}
 
 			// Update the low-link value based on the recursive result.
 			if tarjan.lowLinkValue[neighbor] < tarjan.lowLinkValue[vertex] {
@@ -76,25 +117,94 @@ func (tarjan *TarjanStronglyConnectedComponent) strongConnect(vertex int) {
 
 	// If the current vertex is a root of an SCC.
 	if tarjan.lowLinkValue[vertex] == tarjan.nodeIndex[vertex] {
-		var component []int
+		tarjan.popComponent(vertex)
+	}
+}
+
+// searchFrame records a vertex being explored by strongConnectIterative and the position of the next neighbor to visit.
+type searchFrame struct {
+	vertex   int
+	neighbor int
+}
 
-		// Pop vertices from the stack to form the strongly connected components.
-		for {
-			var popped int = tarjan.stack[len(tarjan.stack)-1]
+// strongConnectIterative performs the same search as strongConnect, but keeps the DFS path on an explicit stack of
+// frames instead of the call stack, so its memory use does not depend on the depth of the graph.
+func (tarjan *TarjanStronglyConnectedComponent) strongConnectIterative(root int) {
+	var frames []searchFrame = []searchFrame{{vertex: root}}
 
-			tarjan.stack = tarjan.stack[:len(tarjan.stack)-1]
-			tarjan.onStack[popped] = false
+	tarjan.nodeIndex[root] = tarjan.index
+	tarjan.lowLinkValue[root] = tarjan.index
+	tarjan.index++
+	tarjan.stack = append(tarjan.stack, root)
+	tarjan.onStack[root] = true
 
-			component = append(component, popped)
+	for len(frames) > 0 {
+		var frame *searchFrame = &frames[len(frames)-1]
+		var vertex int = frame.vertex
 
-			// Stop when the current root vertex is reached.
-			if popped == vertex {
-				break
+		// Visit the next neighbor, descending into it if it has not been discovered yet.
+		if frame.neighbor < len(tarjan.graph[vertex]) {
+			var neighbor int = tarjan.graph[vertex][frame.neighbor]
+
+			frame.neighbor++
+
+			if _, visited := tarjan.nodeIndex[neighbor]; !visited {
+				tarjan.nodeIndex[neighbor] = tarjan.index
+				tarjan.lowLinkValue[neighbor] = tarjan.index
+				tarjan.index++
+				tarjan.stack = append(tarjan.stack, neighbor)
+				tarjan.onStack[neighbor] = true
+
+				frames = append(frames, searchFrame{vertex: neighbor})
+			} else if tarjan.onStack[neighbor] && tarjan.nodeIndex[neighbor] < tarjan.lowLinkValue[vertex] {
+				tarjan.lowLinkValue[vertex] = tarjan.nodeIndex[neighbor]
 			}
+
+			continue
 		}
 
-		// Append the identified component to the result list.
-		tarjan.stronglyConnectedComponents = append(tarjan.stronglyConnectedComponents, component)
+		// Every neighbor is done: close the vertex and pass its low-link value to its parent.
+		frames = frames[:len(frames)-1]
+
+		if tarjan.lowLinkValue[vertex] == tarjan.nodeIndex[vertex] {
+			tarjan.popComponent(vertex)
+		}
+
+		if len(frames) > 0 {
+			var parent int = frames[len(frames)-1].vertex
+
+			if tarjan.lowLinkValue[vertex] < tarjan.lowLinkValue[parent] {
+				tarjan.lowLinkValue[parent] = tarjan.lowLinkValue[vertex]
+			}
+		}
+	}
+}
+
+// popComponent pops the strongly connected component rooted at the vertex off the stack, records it, and reports it
+// to OnComponent.
+func (tarjan *TarjanStronglyConnectedComponent) popComponent(vertex int) {
+	var component []int
+
+	// Pop vertices from the stack to form the strongly connected components.
+	for {
+		var popped int = tarjan.stack[len(tarjan.stack)-1]
+
+		tarjan.stack = tarjan.stack[:len(tarjan.stack)-1]
+		tarjan.onStack[popped] = false
+
+		component = append(component, popped)
+
+		// Stop when the current root vertex is reached.
+		if popped == vertex {
+			break
+		}
+	}
+
+	// Append the identified component to the result list.
+	tarjan.stronglyConnectedComponents = append(tarjan.stronglyConnectedComponents, component)
+
+	if tarjan.OnComponent != nil {
+		tarjan.OnComponent(component)
 	}
 }
 
@@ -104,10 +214,129 @@ func (tarjan *TarjanStronglyConnectedComponent) FindStronglyConnectedComponents(
 	// Visit all vertices in the graph. Start DFS if the vertex has not been visited yet.
 	for vertex := range tarjan.graph {
 		if _, visited := tarjan.nodeIndex[vertex]; !visited {
-			tarjan.strongConnect(vertex)
+			tarjan.strongConnect(vertex, 0)
 		}
 	}
 
 	// Return the complete list of strongly connected components.
 	return tarjan.stronglyConnectedComponents
 }
+
+// SizeHistogram returns a map from component size to the number of strongly connected components of that size. For
+// example, two 3-cycles and one isolated vertex yield {1: 1, 3: 2}. The components are found first if needed.
+func (tarjan *TarjanStronglyConnectedComponent) SizeHistogram() map[int]int {
+	var histogram map[int]int = make(map[int]int)
+
+	for _, component := range tarjan.FindStronglyConnectedComponents() {
+		histogram[len(component)]++
+	}
+
+	return histogram
+}
+
+// hasSelfLoop reports whether the vertex has an edge to itself.
+func (tarjan *TarjanStronglyConnectedComponent) hasSelfLoop(vertex int) bool {
+	for _, neighbor := range tarjan.graph[vertex] {
+		if neighbor == vertex {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shortestPathWithin finds a shortest path of at least one edge from source to target using
+// breadth-first search restricted to the member vertices, so a path from a vertex back to itself
+// is a cycle. The returned path excludes source and ends at target; it is nil if none exists.
+func (tarjan *TarjanStronglyConnectedComponent) shortestPathWithin(source int, target int, members map[int]bool) []int {
+	var parent map[int]int = make(map[int]int)
+	var queue []int = []int{source}
+	var found bool = false
+
+	for len(queue) > 0 && !found {
+		var current int = queue[0]
+
+		queue = queue[1:]
+
+		for _, neighbor := range tarjan.graph[current] {
+			if neighbor == target {
+				parent[target] = current
+				found = true
+
+				break
+			}
+
+			// Expand each member vertex once; the source is already expanded.
+			if _, discovered := parent[neighbor]; discovered || neighbor == source || !members[neighbor] {
+				continue
+			}
+
+			parent[neighbor] = current
+			queue = append(queue, neighbor)
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	// Follow parents back from the target, then reverse into forward order.
+	var path []int = []int{target}
+
+	for vertex := parent[target]; vertex != source; vertex = parent[vertex] {
+		path = append(path, vertex)
+	}
+
+	for left, right := 0, len(path)-1; left < right; left, right = left+1, right-1 {
+		path[left], path[right] = path[right], path[left]
+	}
+
+	return path
+}
+
+// CyclesInComponents returns one closed walk for every strongly connected component that
+// contains a cycle: components with more than one vertex, and single vertices with a self-loop.
+// Singleton acyclic components produce nothing.
+//
+// Each walk starts at a vertex of its component, visits every vertex of that component by
+// following shortest paths inside it, and ends back at the start, so the first and last
+// entries are equal and each consecutive pair is an edge of the graph. A self-loop yields
+// [v v]. Vertices may repeat, since not every component has a Hamiltonian cycle.
+func (tarjan *TarjanStronglyConnectedComponent) CyclesInComponents() [][]int {
+	var cycles [][]int = [][]int{}
+
+	for _, component := range tarjan.FindStronglyConnectedComponents() {
+		if len(component) == 1 && !tarjan.hasSelfLoop(component[0]) {
+			continue
+		}
+
+		var members map[int]bool = make(map[int]bool)
+
+		for _, vertex := range component {
+			members[vertex] = true
+		}
+
+		var start int = component[0]
+		var walk []int = []int{start}
+		var visited map[int]bool = map[int]bool{start: true}
+
+		// Extend the walk to each vertex not yet covered.
+		for _, vertex := range component[1:] {
+			if visited[vertex] {
+				continue
+			}
+
+			for _, step := range tarjan.shortestPathWithin(walk[len(walk)-1], vertex, members) {
+				walk = append(walk, step)
+				visited[step] = true
+			}
+		}
+
+		// Close the walk; strong connectivity guarantees a way back.
+		walk = append(walk, tarjan.shortestPathWithin(walk[len(walk)-1], start, members)...)
+
+		cycles = append(cycles, walk)
+	}
+
+	return cycles
+}
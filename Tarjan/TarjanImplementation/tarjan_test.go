@@ -13,6 +13,13 @@
 //	- Cyclic and acyclic graphs
 //	- Self-loops and single-node components
 //	- Complex intertwined components
+//	- Concrete cycles extracted from non-trivial components
+//	- Histograms of component sizes
+//	- Construction from a directed edge list
+//	- Cross-validation against Kosaraju's algorithm
+//	- Graph transposition
+//	- Component callbacks fired once per component as it is finalized
+//	- The iterative fallback beyond a maximum recursion depth
 //
 //	All tests are written using Go’s built-in "testing" package.
 //
@@ -28,6 +35,18 @@
 //	✅ TestEmptyGraph
 //	✅ TestLinearGraphNoCycles
 //	✅ TestComponentWithBackEdge
+//	✅ TestSizeHistogram
+//	✅ TestCyclesInComponentsAreValid
+//	✅ TestCyclesInComponentsSelfLoopAndAcyclic
+//	✅ TestNewFromEdgesMatchesMapConstruction
+//	✅ TestNewFromEdgesRegistersTargets
+//	✅ TestKosarajuMatchesTarjan
+//	✅ TestTransposeExampleGraph
+//	✅ TestTransposeUndeclaredNeighbors
+//	✅ TestOnComponentFiresPerComponent
+//	✅ TestOnComponentOrder
+//	✅ TestMaxRecursionDepthMatchesUnbounded
+//	✅ TestMaxRecursionDepthDeepGraph
 //
 // Usage:
 //
@@ -215,3 +234,369 @@ func TestComponentsWithBackEdge(test *testing.T) {
 	// Assert.
 	assertComponentEqual(test, result, expected)
 }
+
+// exampleGraph returns the two-component graph used by main.go.
+func exampleGraph() map[int][]int {
+	return map[int][]int{
+		0: {1},
+		1: {2},
+		2: {0},
+		3: {1, 4},
+		4: {5},
+		5: {3},
+	}
+}
+
+// assertValidCycle checks that the walk is closed, follows edges of the graph, and visits
+// exactly the vertices of one of the given components.
+func assertValidCycle(test *testing.T, graph map[int][]int, walk []int, components [][]int) {
+	if len(walk) < 2 || walk[0] != walk[len(walk)-1] {
+		test.Errorf("Expected a closed walk, got %v.", walk)
+
+		return
+	}
+
+	for index := 0; index < len(walk)-1; index++ {
+		var isEdge bool = false
+
+		for _, neighbor := range graph[walk[index]] {
+			if neighbor == walk[index+1] {
+				isEdge = true
+			}
+		}
+
+		if !isEdge {
+			test.Errorf("Walk %v uses missing edge %d -> %d.", walk, walk[index], walk[index+1])
+		}
+	}
+
+	var vertices map[int]bool = make(map[int]bool)
+
+	for _, vertex := range walk {
+		vertices[vertex] = true
+	}
+
+	for _, component := range components {
+		if len(component) != len(vertices) {
+			continue
+		}
+
+		var matches bool = true
+
+		for _, vertex := range component {
+			matches = matches && vertices[vertex]
+		}
+
+		if matches {
+			return
+		}
+	}
+
+	test.Errorf("Walk %v does not cover exactly one component of %v.", walk, components)
+}
+
+// TestSizeHistogram verifies the component size counts of the example graph, with and without an isolated vertex.
+func TestSizeHistogram(test *testing.T) {
+	// Arrange.
+	var withIsolated map[int][]int = exampleGraph()
+
+	withIsolated[6] = []int{}
+
+	var tests = []struct {
+		name     string
+		graph    map[int][]int
+		expected map[int]int
+	}{
+		{name: "example", graph: exampleGraph(), expected: map[int]int{3: 2}},
+		{name: "with isolated vertex", graph: withIsolated, expected: map[int]int{1: 1, 3: 2}},
+		{name: "empty", graph: map[int][]int{}, expected: map[int]int{}},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var finder *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(specificTest.graph)
+		var histogram map[int]int = finder.SizeHistogram()
+
+		// Assert.
+		if !reflect.DeepEqual(histogram, specificTest.expected) {
+			test.Errorf("SizeHistogram() on %s = %v; want %v.", specificTest.name, histogram, specificTest.expected)
+		}
+
+		// Repeated calls must not count the components twice.
+		if again := finder.SizeHistogram(); !reflect.DeepEqual(again, specificTest.expected) {
+			test.Errorf("Second SizeHistogram() on %s = %v; want %v.", specificTest.name, again, specificTest.expected)
+		}
+	}
+}
+
+// TestCyclesInComponentsAreValid verifies that the example graph yields one valid cycle per component.
+func TestCyclesInComponentsAreValid(test *testing.T) {
+	// Arrange.
+	var graph map[int][]int = exampleGraph()
+
+	var finder *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(graph)
+	var components [][]int = [][]int{{0, 1, 2}, {3, 4, 5}}
+
+	// Act.
+	var cycles [][]int = finder.CyclesInComponents()
+
+	// Assert.
+	if len(cycles) != 2 {
+		test.Fatalf("Expected 2 cycles, got %v.", cycles)
+	}
+
+	for _, walk := range cycles {
+		assertValidCycle(test, graph, walk, components)
+	}
+}
+
+// TestCyclesInComponentsSelfLoopAndAcyclic verifies that a self-loop yields [v v] and that
+// acyclic singleton components produce nothing.
+func TestCyclesInComponentsSelfLoopAndAcyclic(test *testing.T) {
+	// Arrange.
+	var graph map[int][]int = map[int][]int{
+		1: {1, 2},
+		2: {3},
+		3: {},
+	}
+
+	var finder *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(graph)
+
+	// Act.
+	var cycles [][]int = finder.CyclesInComponents()
+
+	// Assert.
+	if !reflect.DeepEqual(cycles, [][]int{{1, 1}}) {
+		test.Errorf("Expected [[1 1]], got %v.", cycles)
+	}
+}
+
+// TestNewFromEdgesMatchesMapConstruction verifies that building the example graph from its edge list
+// yields the same components as the map-based construction.
+func TestNewFromEdgesMatchesMapConstruction(test *testing.T) {
+	// Arrange.
+	var edges [][2]int = [][2]int{
+		{0, 1}, {1, 2}, {2, 0},
+		{3, 1}, {3, 4}, {4, 5}, {5, 3},
+	}
+
+	var fromEdges *TarjanStronglyConnectedComponent = NewFromEdges(edges)
+	var fromMap *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(exampleGraph())
+
+	// Act.
+	var actual [][]int = fromEdges.FindStronglyConnectedComponents()
+	var expected [][]int = fromMap.FindStronglyConnectedComponents()
+
+	// Assert.
+	assertComponentEqual(test, actual, expected)
+}
+
+// TestNewFromEdgesRegistersTargets verifies that vertices appearing only as edge targets become components.
+func TestNewFromEdgesRegistersTargets(test *testing.T) {
+	// Arrange.
+	var finder *TarjanStronglyConnectedComponent = NewFromEdges([][2]int{{1, 2}, {2, 3}})
+
+	// Act.
+	var actual [][]int = finder.FindStronglyConnectedComponents()
+
+	// Assert.
+	assertComponentEqual(test, actual, [][]int{{1}, {2}, {3}})
+}
+
+// TestKosarajuMatchesTarjan verifies that Kosaraju's algorithm finds the same components as Tarjan's
+// algorithm on every graph used in this file.
+func TestKosarajuMatchesTarjan(test *testing.T) {
+	// Arrange.
+	var graphs map[string]map[int][]int = map[string]map[int][]int{
+		"single cycle":    {1: {2}, 2: {3}, 3: {1}},
+		"disconnected":    {1: {}, 2: {}, 3: {}},
+		"multiple":        {0: {1}, 1: {2}, 2: {0}, 3: {4}, 4: {5}, 5: {3}, 6: {}},
+		"self loop":       {1: {1}, 2: {3}, 3: {2}},
+		"empty":           {},
+		"linear":          {1: {2}, 2: {3}, 3: {}},
+		"back edge":       {1: {2}, 2: {3}, 3: {4}, 4: {2}, 5: {}},
+		"example":         exampleGraph(),
+		"implicit target": {1: {2}, 2: {1, 3}},
+	}
+
+	for name, graph := range graphs {
+		test.Run(name, func(test *testing.T) {
+			// Act.
+			var expected [][]int = NewTarjanStronglyConnectedComponent(graph).FindStronglyConnectedComponents()
+			var actual [][]int = KosarajuStronglyConnectedComponents(graph)
+
+			// Assert.
+			assertComponentEqual(test, actual, expected)
+		})
+	}
+}
+
+// TestTransposeExampleGraph verifies the reversed edges of the example graph and that transposing
+// twice restores the original.
+func TestTransposeExampleGraph(test *testing.T) {
+	// Arrange.
+	var graph map[int][]int = exampleGraph()
+
+	var expected map[int][]int = map[int][]int{
+		0: {2},
+		1: {0, 3},
+		2: {1},
+		3: {5},
+		4: {3},
+		5: {4},
+	}
+
+	// Act.
+	var transposed map[int][]int = Transpose(graph)
+	var restored map[int][]int = Transpose(transposed)
+
+	// Assert.
+	if !reflect.DeepEqual(transposed, expected) {
+		test.Errorf("Expected transpose %v, got %v.", expected, transposed)
+	}
+
+	if !reflect.DeepEqual(restored, graph) {
+		test.Errorf("Expected double transpose to restore %v, got %v.", graph, restored)
+	}
+}
+
+// TestTransposeUndeclaredNeighbors verifies that isolated vertices and neighbors missing from the keys
+// both appear as vertices of the transposed graph.
+func TestTransposeUndeclaredNeighbors(test *testing.T) {
+	// Arrange.
+	var graph map[int][]int = map[int][]int{
+		1: {2},
+		3: {},
+	}
+
+	var expected map[int][]int = map[int][]int{
+		1: {},
+		2: {1},
+		3: {},
+	}
+
+	// Act.
+	var transposed map[int][]int = Transpose(graph)
+
+	// Assert.
+	if !reflect.DeepEqual(transposed, expected) {
+		test.Errorf("Expected transpose %v, got %v.", expected, transposed)
+	}
+}
+
+// TestOnComponentFiresPerComponent verifies that the callback is called exactly once for every component, with the
+// same vertices that are returned.
+func TestOnComponentFiresPerComponent(test *testing.T) {
+	// Arrange.
+	var reported [][]int
+	var finder *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(
+		map[int][]int{0: {1}, 1: {2}, 2: {0}, 3: {4}, 4: {5}, 5: {3}, 6: {}})
+
+	finder.OnComponent = func(component []int) {
+		reported = append(reported, append([]int{}, component...))
+	}
+
+	// Act.
+	var result [][]int = finder.FindStronglyConnectedComponents()
+
+	// Assert.
+	if len(reported) != len(result) {
+		test.Fatalf("OnComponent fired %d times; want %d.", len(reported), len(result))
+	}
+
+	assertComponentEqual(test, reported, [][]int{{0, 1, 2}, {3, 4, 5}, {6}})
+}
+
+// TestOnComponentOrder verifies that components are reported as they are finalized: a component is always reported
+// before the components that reach it.
+func TestOnComponentOrder(test *testing.T) {
+	// Arrange.
+	var reported [][]int
+	var finder *TarjanStronglyConnectedComponent = NewFromEdges([][2]int{{1, 2}, {2, 3}, {3, 2}, {3, 4}})
+
+	finder.OnComponent = func(component []int) {
+		var sorted []int = append([]int{}, component...)
+
+		sort.Ints(sorted)
+
+		reported = append(reported, sorted)
+	}
+
+	// Act.
+	finder.FindStronglyConnectedComponents()
+
+	// Assert.
+	var position map[int]int = make(map[int]int)
+
+	for index, component := range reported {
+		position[component[0]] = index
+	}
+
+	if position[4] > position[2] || position[2] > position[1] {
+		test.Errorf("Components reported in order %v; want {4} before {2 3} before {1}.", reported)
+	}
+}
+
+// TestMaxRecursionDepthMatchesUnbounded verifies that the iterative fallback finds the same components as the purely
+// recursive search for every depth limit.
+func TestMaxRecursionDepthMatchesUnbounded(test *testing.T) {
+	// Arrange.
+	var graphs map[string]map[int][]int = map[string]map[int][]int{
+		"single cycle":    {1: {2}, 2: {3}, 3: {1}},
+		"multiple":        {0: {1}, 1: {2}, 2: {0}, 3: {4}, 4: {5}, 5: {3}, 6: {}},
+		"self loop":       {1: {1}, 2: {3}, 3: {2}},
+		"linear":          {1: {2}, 2: {3}, 3: {}},
+		"back edge":       {1: {2}, 2: {3}, 3: {4}, 4: {2}, 5: {}},
+		"example":         exampleGraph(),
+		"implicit target": {1: {2}, 2: {1, 3}},
+		"nested cycles":   {1: {2}, 2: {3, 5}, 3: {4}, 4: {2}, 5: {6}, 6: {5, 1}, 7: {6}},
+	}
+
+	for name, graph := range graphs {
+		test.Run(name, func(test *testing.T) {
+			var expected [][]int = NewTarjanStronglyConnectedComponent(graph).FindStronglyConnectedComponents()
+
+			for depth := 1; depth <= 4; depth++ {
+				// Act.
+				var finder *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(graph)
+
+				finder.MaxRecursionDepth = depth
+
+				var actual [][]int = finder.FindStronglyConnectedComponents()
+
+				// Assert.
+				assertComponentEqual(test, actual, expected)
+			}
+		})
+	}
+}
+
+// TestMaxRecursionDepthDeepGraph verifies that a very long cycle, whose depth-first search path holds every vertex, is
+// found as a single component with a small recursion limit.
+func TestMaxRecursionDepthDeepGraph(test *testing.T) {
+	// Arrange.
+	const vertices int = 200000
+
+	var graph map[int][]int = make(map[int][]int, vertices)
+
+	for vertex := 0; vertex < vertices; vertex++ {
+		graph[vertex] = []int{(vertex + 1) % vertices}
+	}
+
+	var finder *TarjanStronglyConnectedComponent = NewTarjanStronglyConnectedComponent(graph)
+	var components int = 0
+
+	finder.MaxRecursionDepth = 64
+	finder.OnComponent = func(component []int) {
+		components++
+	}
+
+	// Act.
+	var result [][]int = finder.FindStronglyConnectedComponents()
+
+	// Assert.
+	if components != 1 || len(result) != 1 || len(result[0]) != vertices {
+		test.Errorf("Found %d components (callback fired %d times); want one component of %d vertices.", len(result),
+			components, vertices)
+	}
+}
@@ -0,0 +1,75 @@
+// ===================================================================================
+// File:        sync_treap.go
+// Package:     treap
+// Description: This file implements SyncTreap, an ordered set of integer keys backed
+//
+//	by a Treap and safe for concurrent use by multiple goroutines.
+//
+//	Features implemented in this file:
+//	- A sync.RWMutex guarding a seeded Treap
+//	- Insertions and deletions under the write lock
+//	- Membership tests and inclusive range queries under the read lock, so
+//	  readers never block each other
+//
+// Author:      Braiden Gole
+// Created:     July 17, 2025
+//
+// ===================================================================================
+package treapimplementation
+
+import "sync"
+
+// SyncTreap is an ordered set of integer keys that may be shared between goroutines.
+// Mutations take the write lock and are serialized, while reads take the read lock and may
+// run concurrently with each other. The zero value is not usable; create one with NewSyncTreap.
+type SyncTreap struct {
+	mutex sync.RWMutex
+	treap *Treap
+}
+
+// NewSyncTreap creates an empty SyncTreap whose priorities are drawn from a generator seeded
+// with the given seed, as for NewTreap.
+func NewSyncTreap(seed int64) *SyncTreap {
+	return &SyncTreap{treap: NewTreap(seed)}
+}
+
+// Insert adds a key to the set while holding the write lock. Inserting a key that is already
+// present leaves the set unchanged.
+func (set *SyncTreap) Insert(key int) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.treap.Insert(key)
+}
+
+// Delete removes a key from the set, if present, while holding the write lock.
+func (set *SyncTreap) Delete(key int) {
+	set.mutex.Lock()
+	defer set.mutex.Unlock()
+
+	set.treap.Delete(key)
+}
+
+// Contains reports whether the key is in the set while holding the read lock.
+func (set *SyncTreap) Contains(key int) bool {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	return set.treap.Search(key) != nil
+}
+
+// Range returns the keys in the inclusive range [lo, hi] in ascending order, collected while
+// holding the read lock. The keys are returned as a slice rather than passed to a callback so
+// that no caller code runs while the lock is held. An inverted range (lo > hi) yields no keys.
+func (set *SyncTreap) Range(lo int, hi int) []int {
+	set.mutex.RLock()
+	defer set.mutex.RUnlock()
+
+	var keys []int = []int{}
+
+	RangeQuery(set.treap.Root, lo, hi, func(key int, priority int) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
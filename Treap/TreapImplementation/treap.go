@@ -11,11 +11,33 @@
 //	balancing and supports efficient insertion, search, and traversal.
 //
 //	Features implemented in this package:
-//	- TreapNode struct with key, priority, left, and right pointers
+//	- TreapNode struct with key, value payload, priority, subtree size, and child pointers
+//	- Key/value insertion so the Treap can serve as an ordered map
 //	- Randomized priority assignment using a seeded RNG
+//	- Weighted insertion scaling the priority range so heavier keys sit nearer the root
+//	- Treap wrapper with an injectable, caller-seeded RNG for reproducible shapes
 //	- Recursive insertion with rotations to preserve heap order
+//	- Linear-time construction from a sorted slice using a monotonic stack
+//	- Rotation-based deletion that sinks the node to a leaf before removal
+//	- Persistent insertion and deletion by path copying, keeping every earlier
+//	  root valid as a historical version that shares unchanged subtrees
+//	- Split and merge primitives for partitioning and joining treaps
+//	- Inclusive key range deletion in O(log n) via split and merge
+//	- Union, intersection, and difference of two treaps via recursive splits
 //	- Binary search for existing keys
+//	- Order statistics (k-th smallest key and rank) in O(log n) via subtree sizes
+//	- Counting keys strictly less than a value for percentile queries
+//	- Predecessor and successor queries for arbitrary (possibly absent) keys
+//	- Inclusive floor and ceiling queries
+//	- Minimum and maximum keys in O(height) along the outer spines
+//	- The k keys nearest to a query value, expanding outward from its floor and ceiling
 //	- In-order traversal with a callback visitor function
+//	- Pull-style in-order iterator backed by an explicit stack, and ToSlice collector
+//	- Inclusive range queries that prune subtrees outside [lo, hi]
+//	- Height and average depth statistics for checking balance empirically
+//	- Invariant validation reporting the first BST or heap violation
+//	- Deep cloning that preserves keys, values, priorities, and shape
+//	- A SyncTreap ordered set wrapper that is safe for concurrent use (sync_treap.go)
 //	- Explicit tree cleanup to release memory (optional in Go)
 //
 // Author:      Braiden Gole
@@ -33,14 +55,20 @@
 package treapimplementation
 
 import (
+	"fmt"
+	"math"
 	"math/rand"
 	"time"
 )
 
 // TreapNode represents a node in the Treap.
+// Value holds an optional payload, letting the Treap act as an ordered map.
+// The size field counts the nodes in the subtree rooted here, including the node itself.
 type TreapNode struct {
 	Key      int
+	Value    any
 	Priority int
+	size     int
 	left     *TreapNode
 	right    *TreapNode
 }
@@ -53,6 +81,114 @@ func init() {
 	randomNumberGenerator = rand.New(rand.NewSource(time.Now().UnixNano()))
 }
 
+// Treap pairs a root node with the random number generator used to draw priorities
+// for its new nodes. Seeding the generator makes the resulting tree shape reproducible.
+type Treap struct {
+	Root   *TreapNode
+	random *rand.Rand
+}
+
+// NewTreap creates an empty Treap whose priorities are drawn from a generator seeded
+// with the given seed. Two treaps built from the same seed and key sequence have
+// identical shapes.
+func NewTreap(seed int64) *Treap {
+	return &Treap{
+		random: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Insert adds a key to the Treap, drawing its priority from the Treap's own generator.
+func (treap *Treap) Insert(key int) {
+	treap.Root = insert(treap.Root, key, nil, false, randomPriority(treap.random))
+}
+
+// InsertKV adds a key with its value to the Treap, or updates the value if the key exists.
+func (treap *Treap) InsertKV(key int, value any) {
+	treap.Root = insert(treap.Root, key, value, true, randomPriority(treap.random))
+}
+
+// BuildFromSorted constructs a Treap from keys that are already sorted in ascending order
+// and free of duplicates, in O(n) time. Each key receives a random priority and is placed
+// using a monotonic stack holding the right spine of the tree built so far: nodes with
+// lower priority than the new key are popped and become its left subtree, and the new key
+// becomes the right child of the remaining top. The input is not validated.
+func BuildFromSorted(keys []int) *TreapNode {
+	var stack []*TreapNode = make([]*TreapNode, 0, len(keys))
+	var priority func() int = randomPriority(randomNumberGenerator)
+
+	for _, key := range keys {
+		var node *TreapNode = &TreapNode{
+			Key:      key,
+			Priority: priority(),
+		}
+
+		var lastPopped *TreapNode = nil
+
+		// Pop every node on the right spine with a lower priority than the new node.
+		for len(stack) > 0 && stack[len(stack)-1].Priority < node.Priority {
+			lastPopped = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+		}
+
+		// The popped chain holds smaller keys, so it hangs to the left.
+		node.left = lastPopped
+
+		if len(stack) > 0 {
+			stack[len(stack)-1].right = node
+		}
+
+		stack = append(stack, node)
+	}
+
+	if len(stack) == 0 {
+		return nil
+	}
+
+	// The bottom of the stack holds the highest priority and is the root.
+	var root *TreapNode = stack[0]
+
+	// Fill in subtree sizes with a post-order pass.
+	var computeSizes func(node *TreapNode)
+
+	computeSizes = func(node *TreapNode) {
+		if node == nil {
+			return
+		}
+
+		computeSizes(node.left)
+		computeSizes(node.right)
+		updateSize(node)
+	}
+
+	computeSizes(root)
+
+	return root
+}
+
+// Delete removes a key from the Treap if present.
+func (treap *Treap) Delete(key int) {
+	treap.Root = Delete(treap.Root, key)
+}
+
+// Search looks for a key in the Treap and returns the corresponding node, or nil.
+func (treap *Treap) Search(key int) *TreapNode {
+	return Search(treap.Root, key)
+}
+
+// subtreeSize returns the number of nodes in the subtree rooted at node (0 for nil).
+func subtreeSize(node *TreapNode) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.size
+}
+
+// updateSize recomputes the node's subtree size from its children.
+func updateSize(node *TreapNode) {
+	node.size = 1 + subtreeSize(node.left) + subtreeSize(node.right)
+}
+
 // rotateLeft performs a left rotation around the given root.
 //
 //	root            newRoot
@@ -67,6 +203,10 @@ func rotateLeft(root *TreapNode) *TreapNode {
 	// Place root as left child of new root.
 	newRoot.left = root
 
+	// Update sizes bottom-up: root is now below new root.
+	updateSize(root)
+	updateSize(newRoot)
+
 	return newRoot
 }
 
@@ -84,23 +224,73 @@ func rotateRight(root *TreapNode) *TreapNode {
 	// Place root as right child of new root.
 	newRoot.right = root
 
+	// Update sizes bottom-up: root is now below new root.
+	updateSize(root)
+	updateSize(newRoot)
+
 	return newRoot
 }
 
 // Insert adds a new key to the Treap while maintaining both BST and heap properties.
-// If the key already exists, the Treap remains unchanged.
+// If the key already exists, the Treap remains unchanged. Priorities are drawn from the
+// package-level, time-seeded generator; use NewTreap for reproducible priorities.
 func Insert(root *TreapNode, key int) *TreapNode {
+	return insert(root, key, nil, false, randomPriority(randomNumberGenerator))
+}
+
+// InsertKV adds a key with an associated value to the Treap while maintaining both BST
+// and heap properties. If the key already exists, its value is updated in place and the
+// shape of the Treap is unchanged.
+func InsertKV(root *TreapNode, key int, value any) *TreapNode {
+	return insert(root, key, value, true, randomPriority(randomNumberGenerator))
+}
+
+// InsertWeighted adds a new key whose priority is drawn uniformly from [0, weight * 2^31), so
+// keys with a higher weight tend to be rotated closer to the root while the BST and heap
+// properties still hold. A weight of 1 gives the same distribution as Insert. A weight that is
+// not positive (or NaN) yields priority 0, sinking the key towards the leaves, and priorities
+// are capped at math.MaxInt. If the key already exists, the Treap remains unchanged.
+func InsertWeighted(root *TreapNode, key int, weight float64) *TreapNode {
+	return insert(root, key, nil, false, func() int {
+		var priority float64 = weight * float64(1<<31) * randomNumberGenerator.Float64()
+
+		if !(priority > 0) {
+			return 0
+		}
+
+		if priority >= math.MaxInt {
+			return math.MaxInt
+		}
+
+		return int(priority)
+	})
+}
+
+// randomPriority returns a priority source drawing uniformly from [0, 2^31) with random.
+func randomPriority(random *rand.Rand) func() int {
+	return func() int {
+		return random.Intn(1 << 31)
+	}
+}
+
+// insert is the recursive implementation of Insert, InsertKV, and InsertWeighted. The
+// priority source is only called when a new node is created. When overwrite is set, an
+// existing key has its value replaced.
+func insert(root *TreapNode, key int, value any, overwrite bool, priority func() int) *TreapNode {
 	if root == nil {
-		// Create a new node with a random priority.
+		// Create a new node with a freshly drawn priority.
 		return &TreapNode{
 			Key:      key,
-			Priority: randomNumberGenerator.Intn(1 << 31),
+			Value:    value,
+			Priority: priority(),
+			size:     1,
 		}
 	}
 
 	if key < root.Key {
 		// Recurse into the left subtree.
-		root.left = Insert(root.left, key)
+		root.left = insert(root.left, key, value, overwrite, priority)
+		updateSize(root)
 
 		// Heap property violated? Rotate right.
 		if root.left != nil && root.left.Priority > root.Priority {
@@ -108,12 +298,16 @@ func Insert(root *TreapNode, key int) *TreapNode {
 		}
 	} else if key > root.Key {
 		// Recurse into the right subtree.
-		root.right = Insert(root.right, key)
+		root.right = insert(root.right, key, value, overwrite, priority)
+		updateSize(root)
 
 		// Heap property violated? Rotate left.
 		if root.right != nil && root.right.Priority > root.Priority {
 			root = rotateLeft(root)
 		}
+	} else if overwrite {
+		// Duplicate key, update the stored value.
+		root.Value = value
 	} else {
 		// Duplicate key, do nothing...
 	}
@@ -121,8 +315,362 @@ func Insert(root *TreapNode, key int) *TreapNode {
 	return root
 }
 
-// Search looks for a key in the Treap and returns the corresponding node.
-// Returns nil if the key is not found.
+// Delete removes a key from the Treap while maintaining both BST and heap properties.
+// A node with two children is rotated down, always lifting the child with the higher
+// priority, until it becomes a leaf or has a single child and can be spliced out.
+// If the key does not exist, the Treap remains unchanged.
+func Delete(root *TreapNode, key int) *TreapNode {
+	if root == nil {
+		// Key not found, nothing to delete.
+		return nil
+	}
+
+	if key < root.Key {
+		root.left = Delete(root.left, key)
+		updateSize(root)
+
+		return root
+	}
+
+	if key > root.Key {
+		root.right = Delete(root.right, key)
+		updateSize(root)
+
+		return root
+	}
+
+	// Found the node; splice it out if it has at most one child.
+	if root.left == nil {
+		return root.right
+	}
+
+	if root.right == nil {
+		return root.left
+	}
+
+	// Rotate the higher-priority child up, then continue deleting further down.
+	if root.left.Priority > root.right.Priority {
+		root = rotateRight(root)
+		root.right = Delete(root.right, key)
+	} else {
+		root = rotateLeft(root)
+		root.left = Delete(root.left, key)
+	}
+
+	updateSize(root)
+
+	return root
+}
+
+// copyNode returns a shallow copy of the node that shares its children, for path copying.
+func copyNode(node *TreapNode) *TreapNode {
+	var copied TreapNode = *node
+
+	return &copied
+}
+
+// PersistentInsert adds a key like Insert, but never modifies an existing node: every node on
+// the search path is copied, and the rotations that restore heap order are applied to those
+// copies only. The returned root shares all untouched subtrees with the input, which remains a
+// valid Treap holding exactly its previous keys, so older roots serve as historical versions of
+// the set. If the key already exists, the input root is returned unchanged.
+func PersistentInsert(root *TreapNode, key int) *TreapNode {
+	if root == nil {
+		return &TreapNode{
+			Key:      key,
+			Priority: randomPriority(randomNumberGenerator)(),
+			size:     1,
+		}
+	}
+
+	if key < root.Key {
+		var left *TreapNode = PersistentInsert(root.left, key)
+
+		// The key was already present; share the whole subtree.
+		if left == root.left {
+			return root
+		}
+
+		var copied *TreapNode = copyNode(root)
+
+		copied.left = left
+		updateSize(copied)
+
+		// The new left child is itself a copy, so rotating it in place is safe.
+		if left.Priority > copied.Priority {
+			copied = rotateRight(copied)
+		}
+
+		return copied
+	}
+
+	if key > root.Key {
+		var right *TreapNode = PersistentInsert(root.right, key)
+
+		if right == root.right {
+			return root
+		}
+
+		var copied *TreapNode = copyNode(root)
+
+		copied.right = right
+		updateSize(copied)
+
+		if right.Priority > copied.Priority {
+			copied = rotateLeft(copied)
+		}
+
+		return copied
+	}
+
+	return root
+}
+
+// PersistentDelete removes a key like Delete, but never modifies an existing node: the nodes on
+// the path to the key are copied, and the key's two subtrees are joined by a merge that copies
+// the nodes along their inner spines instead of relinking them. The input root remains a valid
+// Treap holding exactly its previous keys. If the key does not exist, the input root is returned
+// unchanged.
+func PersistentDelete(root *TreapNode, key int) *TreapNode {
+	if root == nil {
+		return nil
+	}
+
+	if key == root.Key {
+		return persistentMerge(root.left, root.right)
+	}
+
+	var copied *TreapNode = copyNode(root)
+
+	if key < root.Key {
+		copied.left = PersistentDelete(root.left, key)
+
+		if copied.left == root.left {
+			return root
+		}
+	} else {
+		copied.right = PersistentDelete(root.right, key)
+
+		if copied.right == root.right {
+			return root
+		}
+	}
+
+	updateSize(copied)
+
+	return copied
+}
+
+// persistentMerge combines two treaps like Merge, assuming every key in left is less than every
+// key in right, but copies each node it relinks so neither input is modified.
+func persistentMerge(left *TreapNode, right *TreapNode) *TreapNode {
+	if left == nil {
+		return right
+	}
+
+	if right == nil {
+		return left
+	}
+
+	var copied *TreapNode
+
+	// The higher-priority root stays on top, as in Merge.
+	if left.Priority > right.Priority {
+		copied = copyNode(left)
+		copied.right = persistentMerge(left.right, right)
+	} else {
+		copied = copyNode(right)
+		copied.left = persistentMerge(left, right.left)
+	}
+
+	updateSize(copied)
+
+	return copied
+}
+
+// Split partitions the Treap around the given key. All keys strictly less than key are
+// placed in the left treap and all keys greater than or equal to key in the right treap.
+// Both results keep the BST and heap properties. The input treap is consumed.
+func Split(root *TreapNode, key int) (left *TreapNode, right *TreapNode) {
+	if root == nil {
+		return nil, nil
+	}
+
+	if root.Key < key {
+		// Root and its left subtree belong on the left; split the right subtree.
+		root.right, right = Split(root.right, key)
+		updateSize(root)
+
+		return root, right
+	}
+
+	// Root and its right subtree belong on the right; split the left subtree.
+	left, root.left = Split(root.left, key)
+	updateSize(root)
+
+	return left, root
+}
+
+// Merge combines two treaps into one, assuming every key in left is less than every key
+// in right. The root with the higher priority becomes the root of the merged treap, so
+// the heap property is preserved. Both input treaps are consumed.
+func Merge(left *TreapNode, right *TreapNode) *TreapNode {
+	if left == nil {
+		return right
+	}
+
+	if right == nil {
+		return left
+	}
+
+	if left.Priority > right.Priority {
+		// Left root stays on top; merge its right subtree with the right treap.
+		left.right = Merge(left.right, right)
+		updateSize(left)
+
+		return left
+	}
+
+	// Right root stays on top; merge the left treap with its left subtree.
+	right.left = Merge(left, right.left)
+	updateSize(right)
+
+	return right
+}
+
+// DeleteRange removes every key in the inclusive range [lo, hi] and returns the new root.
+// The Treap is split into the keys below lo, the keys in range, and the keys above hi; the
+// middle segment is discarded and the outer pieces are merged, taking O(log n) expected time.
+// An inverted range (lo > hi) leaves the Treap unchanged.
+func DeleteRange(root *TreapNode, lo int, hi int) *TreapNode {
+	if lo > hi {
+		return root
+	}
+
+	var below, rest *TreapNode = Split(root, lo)
+
+	// Nothing can lie above the largest int, and hi+1 would overflow.
+	if hi == math.MaxInt {
+		return below
+	}
+
+	var _, above *TreapNode = Split(rest, hi+1)
+
+	return Merge(below, above)
+}
+
+// splitAround partitions the Treap into the keys less than key, the node holding key (or nil),
+// and the keys greater than key. The matching node is returned detached from its children.
+// The input treap is consumed.
+func splitAround(root *TreapNode, key int) (left *TreapNode, match *TreapNode, right *TreapNode) {
+	if root == nil {
+		return nil, nil, nil
+	}
+
+	if key < root.Key {
+		left, match, root.left = splitAround(root.left, key)
+		updateSize(root)
+
+		return left, match, root
+	}
+
+	if key > root.Key {
+		root.right, match, right = splitAround(root.right, key)
+		updateSize(root)
+
+		return root, match, right
+	}
+
+	// Found the key; detach the node from both subtrees.
+	left, right = root.left, root.right
+	root.left, root.right = nil, nil
+	updateSize(root)
+
+	return left, root, right
+}
+
+// Union returns a treap holding every key present in either input, without duplicates. The root
+// with the higher priority stays on top and the other treap is split around its key, so the
+// operation runs in O(m log(n/m)) expected time for sizes m <= n. When a key is present in both,
+// the node (and Value) of whichever copy ranks higher by priority is kept. Both inputs are consumed.
+func Union(first *TreapNode, second *TreapNode) *TreapNode {
+	if first == nil {
+		return second
+	}
+
+	if second == nil {
+		return first
+	}
+
+	if first.Priority < second.Priority {
+		first, second = second, first
+	}
+
+	// Drop the duplicate of the root key, if any, from the other treap.
+	var left, _, right *TreapNode = splitAround(second, first.Key)
+
+	first.left = Union(first.left, left)
+	first.right = Union(first.right, right)
+	updateSize(first)
+
+	return first
+}
+
+// Intersection returns a treap holding the keys present in both inputs, in O(m log(n/m)) expected
+// time. When a key is present in both, the node (and Value) of whichever copy ranks higher by
+// priority is kept. Both inputs are consumed.
+func Intersection(first *TreapNode, second *TreapNode) *TreapNode {
+	if first == nil || second == nil {
+		return nil
+	}
+
+	if first.Priority < second.Priority {
+		first, second = second, first
+	}
+
+	var left, match, right *TreapNode = splitAround(second, first.Key)
+
+	var intersectLeft *TreapNode = Intersection(first.left, left)
+	var intersectRight *TreapNode = Intersection(first.right, right)
+
+	// The root key survives only if the other treap holds it too.
+	if match == nil {
+		return Merge(intersectLeft, intersectRight)
+	}
+
+	first.left = intersectLeft
+	first.right = intersectRight
+	updateSize(first)
+
+	return first
+}
+
+// Difference returns a treap holding the keys of first that are not present in second, in
+// O(m log(n/m)) expected time. Both inputs are consumed.
+func Difference(first *TreapNode, second *TreapNode) *TreapNode {
+	if first == nil || second == nil {
+		return first
+	}
+
+	var left, match, right *TreapNode = splitAround(second, first.Key)
+
+	var differenceLeft *TreapNode = Difference(first.left, left)
+	var differenceRight *TreapNode = Difference(first.right, right)
+
+	// The root key is removed if the other treap holds it.
+	if match != nil {
+		return Merge(differenceLeft, differenceRight)
+	}
+
+	first.left = differenceLeft
+	first.right = differenceRight
+	updateSize(first)
+
+	return first
+}
+
+// Search looks for a key in the Treap and returns the corresponding node, whose Value
+// field holds any stored payload. Returns nil if the key is not found.
 func Search(root *TreapNode, key int) *TreapNode {
 	if root == nil || root.Key == key {
 		return root
@@ -135,6 +683,214 @@ func Search(root *TreapNode, key int) *TreapNode {
 	return Search(root.right, key)
 }
 
+// Select returns the node holding the k-th smallest key (0-indexed) in the Treap.
+// Returns nil if k is negative or not less than the number of keys.
+func Select(root *TreapNode, k int) *TreapNode {
+	for root != nil {
+		var leftSize int = subtreeSize(root.left)
+
+		if k < leftSize {
+			// The k-th key lies in the left subtree.
+			root = root.left
+		} else if k > leftSize {
+			// Skip the left subtree and the current node.
+			k -= leftSize + 1
+			root = root.right
+		} else {
+			return root
+		}
+	}
+
+	return nil
+}
+
+// Rank returns the number of keys in the Treap strictly less than the given key.
+// When the key is present, this is its 0-indexed position in sorted order.
+func Rank(root *TreapNode, key int) int {
+	var rank int = 0
+
+	for root != nil {
+		if key <= root.Key {
+			root = root.left
+		} else {
+			// The current node and its whole left subtree are smaller.
+			rank += subtreeSize(root.left) + 1
+			root = root.right
+		}
+	}
+
+	return rank
+}
+
+// CountLess returns the number of keys in the Treap strictly less than the given key, which
+// need not be present. Subtree sizes let it skip whole left subtrees, so it runs in O(log n)
+// expected time rather than O(n). It is the same count as Rank, named for percentile queries.
+func CountLess(root *TreapNode, key int) int {
+	return Rank(root, key)
+}
+
+// Predecessor returns the node with the largest key strictly less than the given key.
+// The key itself need not be present. Returns nil if no smaller key exists.
+func Predecessor(root *TreapNode, key int) *TreapNode {
+	var candidate *TreapNode = nil
+
+	for root != nil {
+		if root.Key < key {
+			// Current node qualifies; look for a larger one on the right.
+			candidate = root
+			root = root.right
+		} else {
+			root = root.left
+		}
+	}
+
+	return candidate
+}
+
+// Successor returns the node with the smallest key strictly greater than the given key.
+// The key itself need not be present. Returns nil if no larger key exists.
+func Successor(root *TreapNode, key int) *TreapNode {
+	var candidate *TreapNode = nil
+
+	for root != nil {
+		if root.Key > key {
+			// Current node qualifies; look for a smaller one on the left.
+			candidate = root
+			root = root.left
+		} else {
+			root = root.right
+		}
+	}
+
+	return candidate
+}
+
+// Floor returns the greatest key in the Treap less than or equal to the given key.
+// The boolean result is false if no such key exists.
+func Floor(root *TreapNode, key int) (int, bool) {
+	var floor int = 0
+	var found bool = false
+
+	for root != nil {
+		if root.Key == key {
+			return root.Key, true
+		}
+
+		if root.Key < key {
+			// Current key qualifies; look for a closer one on the right.
+			floor = root.Key
+			found = true
+			root = root.right
+		} else {
+			root = root.left
+		}
+	}
+
+	return floor, found
+}
+
+// Ceiling returns the smallest key in the Treap greater than or equal to the given key.
+// The boolean result is false if no such key exists.
+func Ceiling(root *TreapNode, key int) (int, bool) {
+	var ceiling int = 0
+	var found bool = false
+
+	for root != nil {
+		if root.Key == key {
+			return root.Key, true
+		}
+
+		if root.Key > key {
+			// Current key qualifies; look for a closer one on the left.
+			ceiling = root.Key
+			found = true
+			root = root.left
+		} else {
+			root = root.right
+		}
+	}
+
+	return ceiling, found
+}
+
+// Min returns the smallest key in the Treap by walking its leftmost spine.
+// The boolean result is false if the Treap is empty.
+func Min(root *TreapNode) (int, bool) {
+	if root == nil {
+		return 0, false
+	}
+
+	for root.left != nil {
+		root = root.left
+	}
+
+	return root.Key, true
+}
+
+// Max returns the largest key in the Treap by walking its rightmost spine.
+// The boolean result is false if the Treap is empty.
+func Max(root *TreapNode) (int, bool) {
+	if root == nil {
+		return 0, false
+	}
+
+	for root.right != nil {
+		root = root.right
+	}
+
+	return root.Key, true
+}
+
+// NearestK returns the k keys closest to query by absolute difference, ordered by increasing
+// distance with ties broken by the smaller key. Two cursors start at the floor and ceiling of
+// query and move outward one predecessor or successor at a time, so the cost is O(k log n).
+// Fewer than k keys are returned if the Treap is smaller, and none if k is not positive.
+func NearestK(root *TreapNode, query int, k int) []int {
+	var nearest []int = []int{}
+
+	lower, hasLower := Floor(root, query)
+	upper, hasUpper := Ceiling(root, query)
+
+	// A present query is found by both cursors; let the lower one own it.
+	if hasLower && hasUpper && lower == upper {
+		var next *TreapNode = Successor(root, upper)
+
+		hasUpper = next != nil
+
+		if hasUpper {
+			upper = next.Key
+		}
+	}
+
+	for len(nearest) < k && (hasLower || hasUpper) {
+		// On equal distances the lower cursor holds the smaller key, so it goes first. Distances
+		// are compared as unsigned values, which cannot overflow for keys at opposite extremes.
+		if hasLower && (!hasUpper || uint(query)-uint(lower) <= uint(upper)-uint(query)) {
+			nearest = append(nearest, lower)
+
+			var previous *TreapNode = Predecessor(root, lower)
+
+			hasLower = previous != nil
+
+			if hasLower {
+				lower = previous.Key
+			}
+		} else {
+			nearest = append(nearest, upper)
+
+			var next *TreapNode = Successor(root, upper)
+
+			hasUpper = next != nil
+
+			if hasUpper {
+				upper = next.Key
+			}
+		}
+	}
+
+	return nearest
+}
+
 // InOrder performs an in-order traversal of the Treap,
 // applying the given visit function to each node's key and priority.
 func InOrder(root *TreapNode, visit func(int, int)) {
@@ -150,6 +906,180 @@ func InOrder(root *TreapNode, visit func(int, int)) {
 	}
 }
 
+// ToSlice returns all keys in the Treap in ascending order.
+func ToSlice(root *TreapNode) []int {
+	var keys []int = make([]int, 0, subtreeSize(root))
+
+	InOrder(root, func(key int, priority int) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
+
+// Iterator returns a function that yields the Treap's keys in ascending order, one per call.
+// The boolean result is false once every key has been returned. Traversal uses an explicit
+// stack rather than recursion, so only O(height) state is kept between calls.
+func Iterator(root *TreapNode) func() (int, bool) {
+	var stack []*TreapNode = []*TreapNode{}
+
+	// pushLeft descends along left children, stacking each node on the way.
+	var pushLeft = func(node *TreapNode) {
+		for node != nil {
+			stack = append(stack, node)
+			node = node.left
+		}
+	}
+
+	pushLeft(root)
+
+	return func() (int, bool) {
+		if len(stack) == 0 {
+			return 0, false
+		}
+
+		// The top of the stack holds the next smallest key.
+		var node *TreapNode = stack[len(stack)-1]
+
+		stack = stack[:len(stack)-1]
+
+		// Its successors in the right subtree come next.
+		pushLeft(node.right)
+
+		return node.Key, true
+	}
+}
+
+// RangeQuery applies the visit function, in ascending key order, to the key and priority
+// of every node whose key lies in the inclusive range [lo, hi]. Subtrees that fall entirely
+// outside the range are not explored. An inverted range (lo > hi) visits nothing.
+func RangeQuery(root *TreapNode, lo int, hi int, visit func(int, int)) {
+	if root == nil || lo > hi {
+		return
+	}
+
+	// Keys smaller than the current key can only be in range if the current key exceeds lo.
+	if root.Key > lo {
+		RangeQuery(root.left, lo, hi, visit)
+	}
+
+	if root.Key >= lo && root.Key <= hi {
+		visit(root.Key, root.Priority)
+	}
+
+	// Keys larger than the current key can only be in range if the current key is below hi.
+	if root.Key < hi {
+		RangeQuery(root.right, lo, hi, visit)
+	}
+}
+
+// Height returns the number of edges on the longest path from the root to a leaf.
+// A single node has height 0 and an empty Treap has height -1.
+func Height(root *TreapNode) int {
+	if root == nil {
+		return -1
+	}
+
+	var leftHeight int = Height(root.left)
+	var rightHeight int = Height(root.right)
+
+	if leftHeight > rightHeight {
+		return leftHeight + 1
+	}
+
+	return rightHeight + 1
+}
+
+// AverageDepth returns the mean depth of all nodes, where the root has depth 0.
+// Returns 0 for an empty Treap.
+func AverageDepth(root *TreapNode) float64 {
+	var totalDepth int = 0
+	var nodeCount int = 0
+
+	var visit func(node *TreapNode, depth int)
+
+	visit = func(node *TreapNode, depth int) {
+		if node == nil {
+			return
+		}
+
+		totalDepth += depth
+		nodeCount++
+
+		visit(node.left, depth+1)
+		visit(node.right, depth+1)
+	}
+
+	visit(root, 0)
+
+	if nodeCount == 0 {
+		return 0
+	}
+
+	return float64(totalDepth) / float64(nodeCount)
+}
+
+// Validate checks both Treap invariants and returns a descriptive error for the first violation
+// found in pre-order, or nil if the Treap is valid. Every key must be greater than all keys in its
+// left subtree and less than all keys in its right subtree, and every priority must be greater than
+// or equal to the priorities of its children.
+func Validate(root *TreapNode) error {
+	return validate(root, nil, nil)
+}
+
+// validate is the recursive implementation of Validate. The lower and upper nodes are the nearest
+// ancestors whose keys bound the subtree from below and above, or nil when unbounded.
+func validate(node *TreapNode, lower *TreapNode, upper *TreapNode) error {
+	if node == nil {
+		return nil
+	}
+
+	// BST property: the key must lie strictly between the bounding ancestors.
+	if lower != nil && node.Key <= lower.Key {
+		return fmt.Errorf("BST property violated: key %d in right subtree of key %d", node.Key, lower.Key)
+	}
+
+	if upper != nil && node.Key >= upper.Key {
+		return fmt.Errorf("BST property violated: key %d in left subtree of key %d", node.Key, upper.Key)
+	}
+
+	// Heap property: neither child may outrank the current node.
+	if node.left != nil && node.left.Priority > node.Priority {
+		return fmt.Errorf("heap property violated: left child %d has priority %d > parent %d priority %d",
+			node.left.Key, node.left.Priority, node.Key, node.Priority)
+	}
+
+	if node.right != nil && node.right.Priority > node.Priority {
+		return fmt.Errorf("heap property violated: right child %d has priority %d > parent %d priority %d",
+			node.right.Key, node.right.Priority, node.Key, node.Priority)
+	}
+
+	if err := validate(node.left, lower, node); err != nil {
+		return err
+	}
+
+	return validate(node.right, node, upper)
+}
+
+// Clone returns a deep copy of the Treap in which every node is newly allocated. Keys, values,
+// priorities, and subtree sizes are copied exactly, so the clone has the same shape as the
+// original, and later insertions or deletions on either treap do not affect the other. Values
+// are copied by assignment, so a pointer payload is still shared.
+func Clone(root *TreapNode) *TreapNode {
+	if root == nil {
+		return nil
+	}
+
+	return &TreapNode{
+		Key:      root.Key,
+		Value:    root.Value,
+		Priority: root.Priority,
+		size:     root.size,
+		left:     Clone(root.left),
+		right:    Clone(root.right),
+	}
+}
+
 // Clears the Treap by recursively setting all node pointers to nil.
 // This helps free memory explicitly, although Go's garbage collector handles it.
 func Clear(root **TreapNode) {
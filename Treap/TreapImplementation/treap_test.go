@@ -12,6 +12,27 @@
 //	- Left and right rotations (rotation correctness and property retention)
 //	- Insertions (duplicate handling, heap ordering, and in-order key ordering)
 //	- Searches (positive, negative, root, and empty-treap cases)
+//	- Deletions (leaf, internal, root, and missing keys)
+//	- Split and merge (partitioning and round-tripping)
+//	- Order statistics (selection, rank, and subtree size maintenance)
+//	- Predecessor and successor queries (present, absent, and boundary keys)
+//	- Deterministic shapes from seeded priority generators
+//	- Key/value payloads (insertion, update, and ordering)
+//	- Inclusive range queries (boundaries, pruning, and inverted ranges)
+//	- Height and depth statistics (small shapes and balance of sequential inserts)
+//	- Iteration (pull-style iterator and ToSlice versus InOrder)
+//	- Floor and ceiling queries (present, absent, and empty-treap cases)
+//	- Minimum and maximum keys (populated and empty treaps)
+//	- The k nearest keys to a query versus a brute-force ordering, including extreme keys
+//	- Weighted insertion (heavier keys shallower on average, degenerate weights)
+//	- Concurrent inserts, deletes, and lookups through SyncTreap (run with -race)
+//	- Linear-time construction from sorted keys
+//	- Invariant validation (valid treaps and hand-built BST and heap violations)
+//	- Range deletion (mid-range bands, boundaries, and inverted ranges)
+//	- Set operations (union, intersection, and difference versus Go maps)
+//	- Counting keys less than a value versus a linear count
+//	- Deep cloning (identical shape and independence from the original)
+//	- Persistent insertion and deletion (earlier versions unchanged, shared subtrees)
 //	- Memory cleanup via explicit clearing of the treap
 //
 //	All tests are written using Go’s built-in "testing" package.
@@ -34,16 +55,68 @@
 //	✅ TestSearchEmptyTreap
 //	✅ TestSearchRootKey
 //	✅ TestClearEmptiesTreap
+//	✅ TestDeleteMaintainsProperties
+//	✅ TestDeleteRoot
+//	✅ TestDeleteMissingKey
+//	✅ TestSplitPartitionsKeys
+//	✅ TestSplitMergeRoundTrip
+//	✅ TestSelectAndRankMatchSortedKeys
+//	✅ TestSubtreeSizesAfterUpdates
+//	✅ TestPredecessorAndSuccessor
+//	✅ TestPredecessorAndSuccessorBoundaries
+//	✅ TestSeededTreapsHaveIdenticalShapes
+//	✅ TestSeededTreapMaintainsProperties
+//	✅ TestInsertKVStoresValues
+//	✅ TestInsertKVUpdatesExistingKey
+//	✅ TestRangeQueryVisitsOnlyInRangeKeys
+//	✅ TestRangeQueryEdgeCases
+//	✅ TestHeightAndAverageDepthSmall
+//	✅ TestSequentialInsertsStayBalanced
+//	✅ TestToSliceAndIteratorMatchInOrder
+//	✅ TestIteratorEmptyTreap
+//	✅ TestFloorAndCeiling
+//	✅ TestFloorAndCeilingEmptyTreap
+//	✅ TestBuildFromSorted
+//	✅ TestBuildFromSortedEmpty
+//	✅ TestValidateAcceptsValidTreaps
+//	✅ TestValidateReportsViolations
+//	✅ TestDeleteRangeRemovesBand
+//	✅ TestDeleteRangeEdgeCases
+//	✅ TestSetOperationsMatchMaps
+//	✅ TestSetOperationsWithEmptyTreap
+//	✅ TestCountLessMatchesLinearCount
+//	✅ TestCountLessEmptyTreap
+//	✅ TestCloneIsIndependent
+//	✅ TestMinAndMax
+//	✅ TestMinAndMaxEmptyTreap
+//	✅ TestNearestKMatchesBruteForce
+//	✅ TestNearestKEdgeCases
+//	✅ TestNearestKExtremeKeys
+//	✅ TestInsertWeightedFavoursHeavyKeys
+//	✅ TestInsertWeightedDegenerateWeights
+//	✅ TestPersistentInsertKeepsOldVersions
+//	✅ TestPersistentDeleteKeepsOldVersions
+//	✅ TestSyncTreapConcurrentAccess
 //
 // Usage:
 //
 //	To run all tests:
 //	$ go test
 //
+//	To check the concurrent tests for data races:
+//	$ go test -race
+//
 // ===================================================================================
 package treapimplementation
 
-import "testing"
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
 
 // ================
 // Rotation Testing
@@ -378,6 +451,132 @@ func TestSearchRootKey(test *testing.T) {
 	}
 }
 
+// ==============
+// Delete Testing
+// ==============
+
+// collectKeys returns the keys of the treap in in-order sequence.
+func collectKeys(root *TreapNode) []int {
+	var keys []int
+
+	InOrder(root, func(key int, priority int) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
+
+// isHeapOrdered reports whether every node's priority is greater than or equal to its children's.
+func isHeapOrdered(node *TreapNode) bool {
+	if node == nil {
+		return true
+	}
+
+	if node.left != nil && node.left.Priority > node.Priority {
+		return false
+	}
+
+	if node.right != nil && node.right.Priority > node.Priority {
+		return false
+	}
+
+	return isHeapOrdered(node.left) && isHeapOrdered(node.right)
+}
+
+// TestDeleteMaintainsProperties verifies that after several deletions the remaining keys
+// are still present in sorted order and the heap property holds.
+func TestDeleteMaintainsProperties(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80, 10, 90}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var expected []int = []int{10, 40, 60, 80}
+
+	// Act.
+	for _, key := range []int{30, 70, 20, 50, 90} {
+		root = Delete(root, key)
+	}
+
+	var result []int = collectKeys(root)
+
+	// Assert.
+	if len(result) != len(expected) {
+		test.Fatalf("Expected keys %v after deletions, got %v.", expected, result)
+	}
+
+	for index := range expected {
+		if result[index] != expected[index] {
+			test.Errorf("Expected keys %v after deletions, got %v.", expected, result)
+			break
+		}
+	}
+
+	if !isHeapOrdered(root) {
+		test.Error("Heap property violated after deletions.")
+	}
+}
+
+// TestDeleteRoot verifies that deleting the root key removes it and keeps the treap valid.
+func TestDeleteRoot(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var rootKey int = root.Key
+
+	// Act.
+	root = Delete(root, rootKey)
+
+	// Assert.
+	if Search(root, rootKey) != nil {
+		test.Errorf("Expected root key %d to be deleted.", rootKey)
+	}
+
+	if len(collectKeys(root)) != len(keys)-1 {
+		test.Errorf("Expected %d keys after deleting root, got %v.", len(keys)-1, collectKeys(root))
+	}
+
+	if !isHeapOrdered(root) {
+		test.Error("Heap property violated after deleting root.")
+	}
+}
+
+// TestDeleteMissingKey verifies that deleting a key that does not exist leaves the treap unchanged.
+func TestDeleteMissingKey(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var rootBefore *TreapNode = root
+
+	// Act.
+	root = Delete(root, 999)
+
+	// Assert.
+	if root != rootBefore {
+		test.Error("Treap changed after deleting missing key.")
+	}
+
+	if len(collectKeys(root)) != len(keys) {
+		test.Errorf("Expected %d keys after deleting missing key, got %v.", len(keys), collectKeys(root))
+	}
+}
+
 // =============
 // Clear Testing
 // =============
@@ -400,3 +599,1503 @@ func TestClearEmptiesTreap(test *testing.T) {
 		test.Error("Expected root to be nil after clear.")
 	}
 }
+
+// =======================
+// Split and Merge Testing
+// =======================
+
+// TestSplitPartitionsKeys verifies that Split places keys below the split key on the left,
+// the remaining keys on the right, and that both halves remain heap ordered.
+func TestSplitPartitionsKeys(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	// Act.
+	left, right := Split(root, 50)
+
+	// Assert.
+	for _, key := range collectKeys(left) {
+		if key >= 50 {
+			test.Errorf("Expected left keys < 50, got %v.", collectKeys(left))
+			break
+		}
+	}
+
+	for _, key := range collectKeys(right) {
+		if key < 50 {
+			test.Errorf("Expected right keys >= 50, got %v.", collectKeys(right))
+			break
+		}
+	}
+
+	if len(collectKeys(left))+len(collectKeys(right)) != len(keys) {
+		test.Errorf("Expected %d keys across both halves, got %v and %v.", len(keys), collectKeys(left), collectKeys(right))
+	}
+
+	if !isHeapOrdered(left) || !isHeapOrdered(right) {
+		test.Error("Heap property violated after split.")
+	}
+}
+
+// TestSplitMergeRoundTrip verifies that splitting and then merging the halves produces
+// the same in-order sequence as the original treap.
+func TestSplitMergeRoundTrip(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80, 10, 90}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var expected []int = collectKeys(root)
+
+	for _, splitKey := range []int{0, 10, 45, 50, 90, 100} {
+		// Act.
+		left, right := Split(root, splitKey)
+
+		root = Merge(left, right)
+
+		var result []int = collectKeys(root)
+
+		// Assert.
+		if len(result) != len(expected) {
+			test.Fatalf("Split(%d) then Merge produced %v; want %v.", splitKey, result, expected)
+		}
+
+		for index := range expected {
+			if result[index] != expected[index] {
+				test.Errorf("Split(%d) then Merge produced %v; want %v.", splitKey, result, expected)
+				break
+			}
+		}
+
+		if !isHeapOrdered(root) {
+			test.Errorf("Heap property violated after Split(%d) then Merge.", splitKey)
+		}
+	}
+}
+
+// ========================
+// Order Statistics Testing
+// ========================
+
+// hasConsistentSizes reports whether every node's size equals one plus its children's sizes.
+func hasConsistentSizes(node *TreapNode) bool {
+	if node == nil {
+		return true
+	}
+
+	if node.size != 1+subtreeSize(node.left)+subtreeSize(node.right) {
+		return false
+	}
+
+	return hasConsistentSizes(node.left) && hasConsistentSizes(node.right)
+}
+
+// TestSelectAndRankMatchSortedKeys verifies that Select and Rank agree with the positions
+// of keys in a sorted slice, and that out-of-range queries are handled.
+func TestSelectAndRankMatchSortedKeys(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80, 10, 90}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var sorted []int = []int{10, 20, 30, 40, 50, 60, 70, 80, 90}
+
+	// Act & Assert.
+	for index, key := range sorted {
+		var node *TreapNode = Select(root, index)
+
+		if node == nil || node.Key != key {
+			test.Errorf("Select(%d) = %v; want key %d.", index, node, key)
+		}
+
+		if rank := Rank(root, key); rank != index {
+			test.Errorf("Rank(%d) = %d; want %d.", key, rank, index)
+		}
+	}
+
+	if node := Select(root, -1); node != nil {
+		test.Errorf("Select(-1) = %v; want nil.", node)
+	}
+
+	if node := Select(root, len(sorted)); node != nil {
+		test.Errorf("Select(%d) = %v; want nil.", len(sorted), node)
+	}
+
+	// Absent keys rank by the number of smaller keys.
+	if rank := Rank(root, 45); rank != 4 {
+		test.Errorf("Rank(45) = %d; want 4.", rank)
+	}
+
+	if rank := Rank(root, 100); rank != len(sorted) {
+		test.Errorf("Rank(100) = %d; want %d.", rank, len(sorted))
+	}
+}
+
+// TestSubtreeSizesAfterUpdates verifies that subtree sizes stay correct through inserts,
+// duplicate inserts, deletions, splits, and merges.
+func TestSubtreeSizesAfterUpdates(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	// Act.
+	for key := 0; key < 100; key++ {
+		root = Insert(root, (key*37)%100)
+	}
+
+	root = Insert(root, 42)
+
+	for key := 0; key < 100; key += 3 {
+		root = Delete(root, key)
+	}
+
+	left, right := Split(root, 50)
+
+	// Assert.
+	if !hasConsistentSizes(left) || !hasConsistentSizes(right) {
+		test.Error("Subtree sizes inconsistent after split.")
+	}
+
+	root = Merge(left, right)
+
+	if !hasConsistentSizes(root) {
+		test.Error("Subtree sizes inconsistent after merge.")
+	}
+
+	if subtreeSize(root) != len(collectKeys(root)) {
+		test.Errorf("Root size %d does not match key count %d.", subtreeSize(root), len(collectKeys(root)))
+	}
+}
+
+// =================================
+// Predecessor and Successor Testing
+// =================================
+
+// TestPredecessorAndSuccessor verifies neighbor lookups for keys that exist in the treap
+// and for keys that fall between existing values.
+func TestPredecessorAndSuccessor(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var tests = []struct {
+		key         int
+		predecessor int
+		successor   int
+	}{
+		{key: 50, predecessor: 40, successor: 60},
+		{key: 30, predecessor: 20, successor: 40},
+		{key: 45, predecessor: 40, successor: 50},
+		{key: 65, predecessor: 60, successor: 70},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var predecessor *TreapNode = Predecessor(root, specificTest.key)
+		var successor *TreapNode = Successor(root, specificTest.key)
+
+		// Assert.
+		if predecessor == nil || predecessor.Key != specificTest.predecessor {
+			test.Errorf("Predecessor(%d) = %v; want key %d.", specificTest.key, predecessor, specificTest.predecessor)
+		}
+
+		if successor == nil || successor.Key != specificTest.successor {
+			test.Errorf("Successor(%d) = %v; want key %d.", specificTest.key, successor, specificTest.successor)
+		}
+	}
+}
+
+// TestPredecessorAndSuccessorBoundaries verifies that nil is returned below the minimum,
+// above the maximum, and for an empty treap.
+func TestPredecessorAndSuccessorBoundaries(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	// Act & Assert.
+	if node := Predecessor(root, 30); node != nil {
+		test.Errorf("Predecessor(30) = %v; want nil for the minimum key.", node)
+	}
+
+	if node := Successor(root, 70); node != nil {
+		test.Errorf("Successor(70) = %v; want nil for the maximum key.", node)
+	}
+
+	if node := Successor(root, 10); node == nil || node.Key != 30 {
+		test.Errorf("Successor(10) = %v; want key 30.", node)
+	}
+
+	if node := Predecessor(root, 100); node == nil || node.Key != 70 {
+		test.Errorf("Predecessor(100) = %v; want key 70.", node)
+	}
+
+	if Predecessor(nil, 10) != nil || Successor(nil, 10) != nil {
+		test.Error("Expected nil neighbors for an empty treap.")
+	}
+}
+
+// ==========================
+// Deterministic Seed Testing
+// ==========================
+
+// sameShape reports whether two treaps have identical structure, keys, and priorities.
+func sameShape(compare *TreapNode, against *TreapNode) bool {
+	if compare == nil || against == nil {
+		return compare == against
+	}
+
+	if compare.Key != against.Key || compare.Priority != against.Priority {
+		return false
+	}
+
+	return sameShape(compare.left, against.left) && sameShape(compare.right, against.right)
+}
+
+// TestSeededTreapsHaveIdenticalShapes builds the same key sequence twice with the same seed
+// and asserts that both treaps are structurally identical.
+func TestSeededTreapsHaveIdenticalShapes(test *testing.T) {
+	// Arrange.
+	var compare *Treap = NewTreap(42)
+	var against *Treap = NewTreap(42)
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80, 10, 90, 35, 65}
+
+	// Act.
+	for _, key := range keys {
+		compare.Insert(key)
+		against.Insert(key)
+	}
+
+	compare.Delete(50)
+	against.Delete(50)
+
+	// Assert.
+	if !sameShape(compare.Root, against.Root) {
+		test.Error("Expected treaps built from the same seed to have identical shapes.")
+	}
+}
+
+// TestSeededTreapMaintainsProperties verifies that a seeded treap still satisfies the BST
+// and heap properties and supports search.
+func TestSeededTreapMaintainsProperties(test *testing.T) {
+	// Arrange.
+	var treap *Treap = NewTreap(7)
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80}
+
+	// Act.
+	for _, key := range keys {
+		treap.Insert(key)
+	}
+
+	var inOrderKeys []int = collectKeys(treap.Root)
+
+	// Assert.
+	for index := 1; index < len(inOrderKeys); index++ {
+		if inOrderKeys[index-1] >= inOrderKeys[index] {
+			test.Errorf("In-order traversal not sorted: %v.", inOrderKeys)
+		}
+	}
+
+	if !isHeapOrdered(treap.Root) {
+		test.Error("Heap property violated in seeded treap.")
+	}
+
+	if node := treap.Search(40); node == nil || node.Key != 40 {
+		test.Errorf("Search(40) = %v; want key 40.", node)
+	}
+}
+
+// =========================
+// Key/Value Payload Testing
+// =========================
+
+// TestInsertKVStoresValues verifies that values inserted with their keys can be retrieved
+// through Search and that in-order key ordering is preserved.
+func TestInsertKVStoresValues(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var entries map[int]string = map[int]string{
+		50: "fifty",
+		30: "thirty",
+		70: "seventy",
+		20: "twenty",
+		40: "forty",
+	}
+
+	// Act.
+	for key, value := range entries {
+		root = InsertKV(root, key, value)
+	}
+
+	// Assert.
+	for key, value := range entries {
+		var node *TreapNode = Search(root, key)
+
+		if node == nil || node.Value != value {
+			test.Errorf("Search(%d) = %v; want value %q.", key, node, value)
+		}
+	}
+
+	var inOrderKeys []int = collectKeys(root)
+
+	for index := 1; index < len(inOrderKeys); index++ {
+		if inOrderKeys[index-1] >= inOrderKeys[index] {
+			test.Errorf("In-order traversal not sorted: %v.", inOrderKeys)
+		}
+	}
+}
+
+// TestInsertKVUpdatesExistingKey verifies that inserting a duplicate key updates its value
+// without adding a node, while plain Insert still leaves an existing value untouched.
+func TestInsertKVUpdatesExistingKey(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	root = InsertKV(root, 10, "old")
+	root = InsertKV(root, 20, "other")
+
+	// Act.
+	root = InsertKV(root, 10, "new")
+	root = Insert(root, 10)
+
+	// Assert.
+	if node := Search(root, 10); node == nil || node.Value != "new" {
+		test.Errorf("Search(10) = %v; want value \"new\".", node)
+	}
+
+	if len(collectKeys(root)) != 2 {
+		test.Errorf("Expected 2 keys after updating, got %v.", collectKeys(root))
+	}
+
+	if subtreeSize(root) != 2 {
+		test.Errorf("Expected root size 2 after updating, got %d.", subtreeSize(root))
+	}
+}
+
+// ===================
+// Range Query Testing
+// ===================
+
+// rangeKeys collects the keys visited by RangeQuery over [lo, hi].
+func rangeKeys(root *TreapNode, lo int, hi int) []int {
+	var keys []int = []int{}
+
+	RangeQuery(root, lo, hi, func(key int, priority int) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
+
+// TestRangeQueryVisitsOnlyInRangeKeys verifies that only keys within the inclusive range are
+// visited, in ascending order, including keys exactly on the boundaries.
+func TestRangeQueryVisitsOnlyInRangeKeys(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	for key := 0; key < 100; key += 5 {
+		root = Insert(root, key)
+	}
+
+	var tests = []struct {
+		lo       int
+		hi       int
+		expected []int
+	}{
+		{lo: 20, hi: 40, expected: []int{20, 25, 30, 35, 40}},
+		{lo: 21, hi: 39, expected: []int{25, 30, 35}},
+		{lo: -10, hi: 5, expected: []int{0, 5}},
+		{lo: 90, hi: 200, expected: []int{90, 95}},
+		{lo: 45, hi: 45, expected: []int{45}},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var result []int = rangeKeys(root, specificTest.lo, specificTest.hi)
+
+		// Assert.
+		if len(result) != len(specificTest.expected) {
+			test.Errorf("RangeQuery(%d, %d) visited %v; want %v.", specificTest.lo, specificTest.hi, result, specificTest.expected)
+			continue
+		}
+
+		for index := range result {
+			if result[index] != specificTest.expected[index] {
+				test.Errorf("RangeQuery(%d, %d) visited %v; want %v.", specificTest.lo, specificTest.hi, result, specificTest.expected)
+				break
+			}
+		}
+	}
+}
+
+// TestRangeQueryEdgeCases verifies that inverted ranges, ranges between keys, and an empty
+// treap visit nothing.
+func TestRangeQueryEdgeCases(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	// Act & Assert.
+	if result := rangeKeys(root, 70, 30); len(result) != 0 {
+		test.Errorf("RangeQuery(70, 30) visited %v; want nothing.", result)
+	}
+
+	if result := rangeKeys(root, 31, 49); len(result) != 0 {
+		test.Errorf("RangeQuery(31, 49) visited %v; want nothing.", result)
+	}
+
+	if result := rangeKeys(nil, 0, 100); len(result) != 0 {
+		test.Errorf("RangeQuery on empty treap visited %v; want nothing.", result)
+	}
+}
+
+// ========================
+// Height and Depth Testing
+// ========================
+
+// TestHeightAndAverageDepthSmall verifies the statistics on hand-built shapes.
+func TestHeightAndAverageDepthSmall(test *testing.T) {
+	// Arrange.
+	var root *TreapNode = &TreapNode{Key: 20, Priority: 30}
+
+	root.left = &TreapNode{Key: 10, Priority: 20}
+	root.right = &TreapNode{Key: 30, Priority: 20}
+	root.right.right = &TreapNode{Key: 40, Priority: 10}
+
+	// Act & Assert.
+	if height := Height(nil); height != -1 {
+		test.Errorf("Height(nil) = %d; want -1.", height)
+	}
+
+	if height := Height(root.left); height != 0 {
+		test.Errorf("Height(leaf) = %d; want 0.", height)
+	}
+
+	if height := Height(root); height != 2 {
+		test.Errorf("Height(root) = %d; want 2.", height)
+	}
+
+	// Depths are 0, 1, 1, and 2.
+	if depth := AverageDepth(root); depth != 1.0 {
+		test.Errorf("AverageDepth(root) = %f; want 1.0.", depth)
+	}
+
+	if depth := AverageDepth(nil); depth != 0 {
+		test.Errorf("AverageDepth(nil) = %f; want 0.", depth)
+	}
+}
+
+// TestSequentialInsertsStayBalanced inserts 1000 sequential keys, the worst case for an
+// unbalanced BST, and asserts the height stays far below the linear bound.
+func TestSequentialInsertsStayBalanced(test *testing.T) {
+	// Arrange.
+	var treap *Treap = NewTreap(2025)
+
+	const KEY_COUNT int = 1000
+
+	// Act.
+	for key := 0; key < KEY_COUNT; key++ {
+		treap.Insert(key)
+	}
+
+	var height int = Height(treap.Root)
+	var averageDepth float64 = AverageDepth(treap.Root)
+
+	// Assert.
+	if height >= 100 {
+		test.Errorf("Height = %d after %d sequential inserts; want well below %d.", height, KEY_COUNT, KEY_COUNT-1)
+	}
+
+	if averageDepth >= 30 {
+		test.Errorf("AverageDepth = %f after %d sequential inserts; want logarithmic.", averageDepth, KEY_COUNT)
+	}
+}
+
+// ================
+// Iterator Testing
+// ================
+
+// TestToSliceAndIteratorMatchInOrder verifies that ToSlice and Iterator produce the same
+// sorted sequence as InOrder.
+func TestToSliceAndIteratorMatchInOrder(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	for key := 0; key < 50; key++ {
+		root = Insert(root, (key*17)%50)
+	}
+
+	var expected []int = collectKeys(root)
+
+	// Act.
+	var slice []int = ToSlice(root)
+
+	var iterated []int
+
+	var next func() (int, bool) = Iterator(root)
+
+	for key, ok := next(); ok; key, ok = next() {
+		iterated = append(iterated, key)
+	}
+
+	// Assert.
+	if len(slice) != len(expected) || len(iterated) != len(expected) {
+		test.Fatalf("Expected %d keys, got ToSlice %v and Iterator %v.", len(expected), slice, iterated)
+	}
+
+	for index := range expected {
+		if slice[index] != expected[index] || iterated[index] != expected[index] {
+			test.Errorf("Sequences differ at %d: InOrder %d, ToSlice %d, Iterator %d.", index, expected[index],
+				slice[index], iterated[index])
+		}
+	}
+}
+
+// TestIteratorEmptyTreap verifies that an iterator over an empty treap is immediately
+// exhausted and stays exhausted.
+func TestIteratorEmptyTreap(test *testing.T) {
+	// Arrange.
+	var next func() (int, bool) = Iterator(nil)
+
+	// Act & Assert.
+	for attempt := 0; attempt < 2; attempt++ {
+		if key, ok := next(); ok {
+			test.Errorf("Expected exhausted iterator, got key %d.", key)
+		}
+	}
+
+	if keys := ToSlice(nil); len(keys) != 0 {
+		test.Errorf("ToSlice(nil) = %v; want empty.", keys)
+	}
+}
+
+// =========================
+// Floor and Ceiling Testing
+// =========================
+
+// TestFloorAndCeiling verifies inclusive neighbor lookups for present keys, keys between
+// existing values, and keys beyond either end of the treap.
+func TestFloorAndCeiling(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	var keys []int = []int{50, 30, 70, 20, 40, 60, 80}
+
+	for _, key := range keys {
+		root = Insert(root, key)
+	}
+
+	var tests = []struct {
+		key          int
+		floor        int
+		floorFound   bool
+		ceiling      int
+		ceilingFound bool
+	}{
+		{key: 40, floor: 40, floorFound: true, ceiling: 40, ceilingFound: true},
+		{key: 45, floor: 40, floorFound: true, ceiling: 50, ceilingFound: true},
+		{key: 10, floor: 0, floorFound: false, ceiling: 20, ceilingFound: true},
+		{key: 90, floor: 80, floorFound: true, ceiling: 0, ceilingFound: false},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		floor, floorFound := Floor(root, specificTest.key)
+		ceiling, ceilingFound := Ceiling(root, specificTest.key)
+
+		// Assert.
+		if floorFound != specificTest.floorFound || (floorFound && floor != specificTest.floor) {
+			test.Errorf("Floor(%d) = %d, %v; want %d, %v.", specificTest.key, floor, floorFound, specificTest.floor,
+				specificTest.floorFound)
+		}
+
+		if ceilingFound != specificTest.ceilingFound || (ceilingFound && ceiling != specificTest.ceiling) {
+			test.Errorf("Ceiling(%d) = %d, %v; want %d, %v.", specificTest.key, ceiling, ceilingFound, specificTest.ceiling,
+				specificTest.ceilingFound)
+		}
+	}
+}
+
+// TestFloorAndCeilingEmptyTreap verifies that no floor or ceiling exists in an empty treap.
+func TestFloorAndCeilingEmptyTreap(test *testing.T) {
+	// Act.
+	_, floorFound := Floor(nil, 10)
+	_, ceilingFound := Ceiling(nil, 10)
+
+	// Assert.
+	if floorFound || ceilingFound {
+		test.Errorf("Expected no floor or ceiling in empty treap, got %v and %v.", floorFound, ceilingFound)
+	}
+}
+
+// =========================
+// Build From Sorted Testing
+// =========================
+
+// TestBuildFromSorted feeds keys 0..999 and asserts the in-order output equals the input,
+// the heap property holds, and subtree sizes are consistent.
+func TestBuildFromSorted(test *testing.T) {
+	// Arrange.
+	var keys []int = make([]int, 1000)
+
+	for index := range keys {
+		keys[index] = index
+	}
+
+	// Act.
+	var root *TreapNode = BuildFromSorted(keys)
+
+	var result []int = collectKeys(root)
+
+	// Assert.
+	if len(result) != len(keys) {
+		test.Fatalf("Expected %d keys, got %d.", len(keys), len(result))
+	}
+
+	for index := range keys {
+		if result[index] != keys[index] {
+			test.Fatalf("In-order key at %d = %d; want %d.", index, result[index], keys[index])
+		}
+	}
+
+	if !isHeapOrdered(root) {
+		test.Error("Heap property violated after BuildFromSorted.")
+	}
+
+	if !hasConsistentSizes(root) || subtreeSize(root) != len(keys) {
+		test.Errorf("Subtree sizes inconsistent after BuildFromSorted; root size %d.", subtreeSize(root))
+	}
+}
+
+// TestBuildFromSortedEmpty verifies that an empty slice produces an empty treap.
+func TestBuildFromSortedEmpty(test *testing.T) {
+	// Act.
+	var root *TreapNode = BuildFromSorted([]int{})
+
+	// Assert.
+	if root != nil {
+		test.Errorf("Expected nil root for empty input, got %v.", root)
+	}
+}
+
+// ==================
+// Validation Testing
+// ==================
+
+// TestValidateAcceptsValidTreaps verifies that empty, inserted, and bulk-built treaps pass validation.
+func TestValidateAcceptsValidTreaps(test *testing.T) {
+	// Arrange.
+	var inserted *TreapNode
+
+	for _, key := range []int{50, 30, 70, 20, 40, 60, 80} {
+		inserted = Insert(inserted, key)
+	}
+
+	var built *TreapNode = BuildFromSorted([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	// Act & Assert.
+	for _, root := range []*TreapNode{nil, inserted, built} {
+		if err := Validate(root); err != nil {
+			test.Errorf("Expected valid treap, got error: %v.", err)
+		}
+	}
+}
+
+// TestValidateReportsViolations hand-builds broken treaps and asserts that each violation is
+// reported with a descriptive error.
+func TestValidateReportsViolations(test *testing.T) {
+	// Arrange.
+	// Key 60 sits in the left subtree of 50 although it is only a grandchild: 50 -> 30 -> 60.
+	var deepBST *TreapNode = &TreapNode{Key: 50, Priority: 30}
+
+	deepBST.left = &TreapNode{Key: 30, Priority: 20}
+	deepBST.left.right = &TreapNode{Key: 60, Priority: 10}
+
+	// The right child has a higher priority than its parent.
+	var brokenHeap *TreapNode = &TreapNode{Key: 10, Priority: 5}
+
+	brokenHeap.right = &TreapNode{Key: 20, Priority: 9}
+
+	var tests = []struct {
+		name     string
+		root     *TreapNode
+		contains string
+	}{
+		{name: "BST violation below a grandparent", root: deepBST, contains: "BST property violated: key 60 in left subtree of key 50"},
+		{name: "heap violation", root: brokenHeap, contains: "heap property violated: right child 20 has priority 9"},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var err error = Validate(specificTest.root)
+
+		// Assert.
+		if err == nil {
+			test.Errorf("%s: expected an error, got nil.", specificTest.name)
+		} else if !strings.Contains(err.Error(), specificTest.contains) {
+			test.Errorf("%s: expected error containing %q, got %q.", specificTest.name, specificTest.contains, err.Error())
+		}
+	}
+}
+
+// ====================
+// Delete Range Testing
+// ====================
+
+// TestDeleteRangeRemovesBand removes the band [30, 59] from a treap holding 0..99 and asserts
+// the remaining keys are exactly those outside the band and both invariants hold.
+func TestDeleteRangeRemovesBand(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+
+	for key := 0; key < 100; key++ {
+		root = Insert(root, key)
+	}
+
+	var expected []int = []int{}
+
+	for key := 0; key < 100; key++ {
+		if key < 30 || key > 59 {
+			expected = append(expected, key)
+		}
+	}
+
+	// Act.
+	root = DeleteRange(root, 30, 59)
+
+	var result []int = ToSlice(root)
+
+	// Assert.
+	if len(result) != len(expected) {
+		test.Fatalf("Expected %d keys after DeleteRange, got %d: %v.", len(expected), len(result), result)
+	}
+
+	for index := range expected {
+		if result[index] != expected[index] {
+			test.Fatalf("Key at %d = %d; want %d.", index, result[index], expected[index])
+		}
+	}
+
+	if err := Validate(root); err != nil {
+		test.Errorf("Invariant violated after DeleteRange: %v.", err)
+	}
+
+	if !hasConsistentSizes(root) || subtreeSize(root) != len(expected) {
+		test.Errorf("Subtree sizes inconsistent after DeleteRange; root size %d.", subtreeSize(root))
+	}
+}
+
+// TestDeleteRangeEdgeCases covers inverted, empty, out-of-range, and unbounded ranges.
+func TestDeleteRangeEdgeCases(test *testing.T) {
+	// Arrange.
+	var build = func() *TreapNode {
+		return BuildFromSorted([]int{10, 20, 30, 40, 50})
+	}
+
+	var tests = []struct {
+		name     string
+		lo       int
+		hi       int
+		expected []int
+	}{
+		{name: "inverted range", lo: 40, hi: 20, expected: []int{10, 20, 30, 40, 50}},
+		{name: "range between keys", lo: 21, hi: 29, expected: []int{10, 20, 30, 40, 50}},
+		{name: "single key", lo: 30, hi: 30, expected: []int{10, 20, 40, 50}},
+		{name: "prefix", lo: math.MinInt, hi: 25, expected: []int{30, 40, 50}},
+		{name: "suffix to max int", lo: 35, hi: math.MaxInt, expected: []int{10, 20, 30}},
+		{name: "everything", lo: 0, hi: 100, expected: []int{}},
+	}
+
+	for _, specificTest := range tests {
+		// Act.
+		var root *TreapNode = DeleteRange(build(), specificTest.lo, specificTest.hi)
+
+		var result []int = ToSlice(root)
+
+		// Assert.
+		if !equalKeys(result, specificTest.expected) {
+			test.Errorf("%s: DeleteRange(%d, %d) left %v; want %v.", specificTest.name, specificTest.lo, specificTest.hi,
+				result, specificTest.expected)
+		}
+
+		if err := Validate(root); err != nil {
+			test.Errorf("%s: invariant violated: %v.", specificTest.name, err)
+		}
+	}
+}
+
+// equalKeys reports whether two key slices hold the same keys in the same order.
+func equalKeys(actual []int, expected []int) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+
+	for index := range actual {
+		if actual[index] != expected[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ======================
+// Set Operations Testing
+// ======================
+
+// randomKeySet draws count keys from [0, limit) and returns them as a set.
+func randomKeySet(random *rand.Rand, count int, limit int) map[int]bool {
+	var set map[int]bool = make(map[int]bool)
+
+	for index := 0; index < count; index++ {
+		set[random.Intn(limit)] = true
+	}
+
+	return set
+}
+
+// treapFromSet inserts every key of the set into a new treap.
+func treapFromSet(set map[int]bool) *TreapNode {
+	var root *TreapNode
+
+	for key := range set {
+		root = Insert(root, key)
+	}
+
+	return root
+}
+
+// sortedKeys returns the keys of the set selected by keep, in ascending order.
+func sortedKeys(set map[int]bool, keep func(int) bool) []int {
+	var keys []int = []int{}
+
+	for key := range set {
+		if keep(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Ints(keys)
+
+	return keys
+}
+
+// TestSetOperationsMatchMaps builds treaps from random key sets of varying sizes and asserts
+// that Union, Intersection, and Difference match the equivalent map-based set operations.
+func TestSetOperationsMatchMaps(test *testing.T) {
+	var random *rand.Rand = rand.New(rand.NewSource(2091))
+
+	for round := 0; round < 20; round++ {
+		// Arrange.
+		var first map[int]bool = randomKeySet(random, 1+random.Intn(200), 300)
+		var second map[int]bool = randomKeySet(random, 1+random.Intn(50), 300)
+
+		var all map[int]bool = make(map[int]bool)
+
+		for key := range first {
+			all[key] = true
+		}
+
+		for key := range second {
+			all[key] = true
+		}
+
+		var tests = []struct {
+			name      string
+			operation func(*TreapNode, *TreapNode) *TreapNode
+			keep      func(int) bool
+		}{
+			{name: "Union", operation: Union, keep: func(key int) bool { return true }},
+			{name: "Intersection", operation: Intersection, keep: func(key int) bool { return first[key] && second[key] }},
+			{name: "Difference", operation: Difference, keep: func(key int) bool { return first[key] && !second[key] }},
+		}
+
+		for _, specificTest := range tests {
+			// Act.
+			var root *TreapNode = specificTest.operation(treapFromSet(first), treapFromSet(second))
+
+			var expected []int = sortedKeys(all, specificTest.keep)
+			var result []int = ToSlice(root)
+
+			// Assert.
+			if !equalKeys(result, expected) {
+				test.Fatalf("Round %d %s: got %v; want %v.", round, specificTest.name, result, expected)
+			}
+
+			if err := Validate(root); err != nil {
+				test.Fatalf("Round %d %s: invariant violated: %v.", round, specificTest.name, err)
+			}
+
+			if !hasConsistentSizes(root) || subtreeSize(root) != len(expected) {
+				test.Fatalf("Round %d %s: subtree sizes inconsistent; root size %d.", round, specificTest.name,
+					subtreeSize(root))
+			}
+		}
+	}
+}
+
+// TestSetOperationsWithEmptyTreap verifies each set operation when one or both inputs are empty.
+func TestSetOperationsWithEmptyTreap(test *testing.T) {
+	// Arrange.
+	var keys []int = []int{1, 2, 3}
+
+	// Act & Assert.
+	if result := ToSlice(Union(nil, BuildFromSorted(keys))); !equalKeys(result, keys) {
+		test.Errorf("Union with empty = %v; want %v.", result, keys)
+	}
+
+	if result := ToSlice(Intersection(BuildFromSorted(keys), nil)); len(result) != 0 {
+		test.Errorf("Intersection with empty = %v; want [].", result)
+	}
+
+	if result := ToSlice(Difference(BuildFromSorted(keys), nil)); !equalKeys(result, keys) {
+		test.Errorf("Difference with empty = %v; want %v.", result, keys)
+	}
+
+	if result := ToSlice(Difference(nil, BuildFromSorted(keys))); len(result) != 0 {
+		test.Errorf("Difference of empty = %v; want [].", result)
+	}
+}
+
+// ==================
+// Count Less Testing
+// ==================
+
+// TestCountLessMatchesLinearCount builds treaps from random key sets and asserts CountLess agrees
+// with a linear scan for present keys, absent keys, and values beyond both ends.
+func TestCountLessMatchesLinearCount(test *testing.T) {
+	var random *rand.Rand = rand.New(rand.NewSource(2092))
+
+	for round := 0; round < 10; round++ {
+		// Arrange.
+		var set map[int]bool = randomKeySet(random, 1+random.Intn(300), 500)
+		var root *TreapNode = treapFromSet(set)
+
+		for key := -5; key <= 505; key++ {
+			var expected int = 0
+
+			for member := range set {
+				if member < key {
+					expected++
+				}
+			}
+
+			// Act.
+			var result int = CountLess(root, key)
+
+			// Assert.
+			if result != expected {
+				test.Fatalf("Round %d: CountLess(%d) = %d; want %d.", round, key, result, expected)
+			}
+		}
+	}
+}
+
+// TestCountLessEmptyTreap verifies that an empty treap has no keys below any value.
+func TestCountLessEmptyTreap(test *testing.T) {
+	// Act.
+	var result int = CountLess(nil, 42)
+
+	// Assert.
+	if result != 0 {
+		test.Errorf("Expected 0 for empty treap, got %d.", result)
+	}
+}
+
+// =============
+// Clone Testing
+// =============
+
+// sharesNode reports whether any node of the first treap is also a node of the second.
+func sharesNode(first *TreapNode, second *TreapNode) bool {
+	var nodes map[*TreapNode]bool = make(map[*TreapNode]bool)
+
+	var collect func(node *TreapNode)
+
+	collect = func(node *TreapNode) {
+		if node != nil {
+			nodes[node] = true
+			collect(node.left)
+			collect(node.right)
+		}
+	}
+
+	collect(first)
+
+	var shared func(node *TreapNode) bool
+
+	shared = func(node *TreapNode) bool {
+		return node != nil && (nodes[node] || shared(node.left) || shared(node.right))
+	}
+
+	return shared(second)
+}
+
+// TestCloneIsIndependent clones a seeded treap, asserts the copy has the same shape without
+// sharing any node, and checks that deleting from the clone leaves the original intact.
+func TestCloneIsIndependent(test *testing.T) {
+	// Arrange.
+	var treap *Treap = NewTreap(2106)
+
+	for key := 1; key <= 50; key++ {
+		treap.Insert(key * 3)
+	}
+
+	// Act.
+	var clone *TreapNode = Clone(treap.Root)
+
+	// Assert.
+	if !sameShape(treap.Root, clone) {
+		test.Fatal("Expected the clone to have the same keys, priorities, and shape.")
+	}
+
+	if sharesNode(treap.Root, clone) {
+		test.Fatal("Expected every node of the clone to be newly allocated.")
+	}
+
+	clone = Delete(clone, 30)
+	clone = Insert(clone, 31)
+
+	if Search(treap.Root, 30) == nil {
+		test.Error("Expected the original to still contain key 30 after deleting it from the clone.")
+	}
+
+	if Search(treap.Root, 31) != nil {
+		test.Error("Expected the original not to contain key 31 after inserting it into the clone.")
+	}
+
+	if Search(clone, 30) != nil || Search(clone, 31) == nil {
+		test.Error("Expected the clone to reflect its own delete and insert.")
+	}
+
+	if err := Validate(clone); err != nil {
+		test.Errorf("Expected a valid clone after updates, got %v.", err)
+	}
+
+	if Clone(nil) != nil {
+		test.Error("Expected cloning an empty treap to return nil.")
+	}
+}
+
+// ===================
+// Min and Max Testing
+// ===================
+
+// TestMinAndMax verifies that the extremes of random key sets are found along the outer spines.
+func TestMinAndMax(test *testing.T) {
+	var random *rand.Rand = rand.New(rand.NewSource(2107))
+
+	for round := 0; round < 10; round++ {
+		// Arrange.
+		var set map[int]bool = randomKeySet(random, 1+random.Intn(300), 1000)
+		var root *TreapNode = treapFromSet(set)
+		var keys []int = sortedKeys(set, func(int) bool { return true })
+
+		// Act.
+		minimum, minimumFound := Min(root)
+		maximum, maximumFound := Max(root)
+
+		// Assert.
+		if !minimumFound || minimum != keys[0] {
+			test.Errorf("Round %d: Min = %d, %v; want %d, true.", round, minimum, minimumFound, keys[0])
+		}
+
+		if !maximumFound || maximum != keys[len(keys)-1] {
+			test.Errorf("Round %d: Max = %d, %v; want %d, true.", round, maximum, maximumFound, keys[len(keys)-1])
+		}
+	}
+}
+
+// TestMinAndMaxEmptyTreap verifies that an empty treap has no minimum or maximum.
+func TestMinAndMaxEmptyTreap(test *testing.T) {
+	// Act.
+	_, minimumFound := Min(nil)
+	_, maximumFound := Max(nil)
+
+	// Assert.
+	if minimumFound || maximumFound {
+		test.Errorf("Expected no minimum or maximum in empty treap, got %v and %v.", minimumFound, maximumFound)
+	}
+}
+
+// ====================
+// Nearest Keys Testing
+// ====================
+
+// TestNearestKMatchesBruteForce builds a dense treap of every third key in [0, 300) and, for
+// queries inside, between, and beyond the keys, compares NearestK with sorting every key by
+// distance and then by value.
+func TestNearestKMatchesBruteForce(test *testing.T) {
+	// Arrange.
+	var root *TreapNode
+	var keys []int = []int{}
+
+	for key := 0; key < 300; key += 3 {
+		root = Insert(root, key)
+		keys = append(keys, key)
+	}
+
+	var distance = func(key int, query int) int {
+		if key < query {
+			return query - key
+		}
+
+		return key - query
+	}
+
+	for query := -10; query <= 310; query++ {
+		var expected []int = append([]int{}, keys...)
+
+		sort.Slice(expected, func(compare int, against int) bool {
+			var compareDistance int = distance(expected[compare], query)
+			var againstDistance int = distance(expected[against], query)
+
+			if compareDistance != againstDistance {
+				return compareDistance < againstDistance
+			}
+
+			return expected[compare] < expected[against]
+		})
+
+		for _, k := range []int{1, 2, 5, 17, len(keys), len(keys) + 5} {
+			// Act.
+			var result []int = NearestK(root, query, k)
+
+			// Assert.
+			var want []int = expected[:min(k, len(expected))]
+
+			if !equalKeys(result, want) {
+				test.Fatalf("NearestK(%d, %d) = %v; want %v.", query, k, result, want)
+			}
+		}
+	}
+}
+
+// TestNearestKEdgeCases verifies that an empty treap or a non-positive k yields no keys.
+func TestNearestKEdgeCases(test *testing.T) {
+	// Arrange.
+	var root *TreapNode = Insert(Insert(nil, 5), 10)
+
+	// Act.
+	var empty []int = NearestK(nil, 7, 3)
+	var zero []int = NearestK(root, 7, 0)
+	var negative []int = NearestK(root, 7, -1)
+
+	// Assert.
+	if len(empty) != 0 || len(zero) != 0 || len(negative) != 0 {
+		test.Errorf("Expected no keys, got %v, %v and %v.", empty, zero, negative)
+	}
+}
+
+// TestNearestKExtremeKeys verifies that distances to keys near opposite ends of the int range
+// are compared without overflowing, so the nearer key is still returned first.
+func TestNearestKExtremeKeys(test *testing.T) {
+	// Arrange.
+	var root *TreapNode = Insert(Insert(nil, math.MinInt+5), math.MaxInt)
+
+	// Act.
+	var fromAbove []int = NearestK(root, 10, 2)
+	var fromBelow []int = NearestK(root, -10, 2)
+
+	// Assert.
+	if !equalKeys(fromAbove, []int{math.MaxInt, math.MinInt + 5}) {
+		test.Errorf("NearestK(10, 2) = %v; want [%d %d].", fromAbove, math.MaxInt, math.MinInt+5)
+	}
+
+	if !equalKeys(fromBelow, []int{math.MinInt + 5, math.MaxInt}) {
+		test.Errorf("NearestK(-10, 2) = %v; want [%d %d].", fromBelow, math.MinInt+5, math.MaxInt)
+	}
+}
+
+// =======================
+// Weighted Insert Testing
+// =======================
+
+// TestInsertWeightedFavoursHeavyKeys inserts 0..1999 in shuffled order, giving every 40th key
+// weight 100 and every other key weight 1, and asserts that the heavy keys sit markedly shallower
+// on average while both Treap invariants hold.
+func TestInsertWeightedFavoursHeavyKeys(test *testing.T) {
+	// Arrange.
+	var root *TreapNode = nil
+	var keys []int = rand.New(rand.NewSource(7)).Perm(2000)
+
+	// Act.
+	for _, key := range keys {
+		var weight float64 = 1
+
+		if key%40 == 0 {
+			weight = 100
+		}
+
+		root = InsertWeighted(root, key, weight)
+	}
+
+	// Index 0 accumulates the heavy keys and index 1 the light keys.
+	var depthSums [2]int
+	var counts [2]int
+
+	var visit func(node *TreapNode, depth int)
+
+	visit = func(node *TreapNode, depth int) {
+		if node == nil {
+			return
+		}
+
+		var group int = 1
+
+		if node.Key%40 == 0 {
+			group = 0
+		}
+
+		depthSums[group] += depth
+		counts[group]++
+
+		visit(node.left, depth+1)
+		visit(node.right, depth+1)
+	}
+
+	visit(root, 0)
+
+	var heavyDepth float64 = float64(depthSums[0]) / float64(counts[0])
+	var lightDepth float64 = float64(depthSums[1]) / float64(counts[1])
+
+	// Assert.
+	if err := Validate(root); err != nil {
+		test.Fatalf("Validate after weighted inserts: %v.", err)
+	}
+
+	if subtreeSize(root) != len(keys) {
+		test.Fatalf("Size = %d after weighted inserts; want %d.", subtreeSize(root), len(keys))
+	}
+
+	if heavyDepth+4 >= lightDepth {
+		test.Errorf("Average depth of heavy keys = %f, light keys = %f; want heavy keys clearly shallower.",
+			heavyDepth, lightDepth)
+	}
+}
+
+// TestInsertWeightedDegenerateWeights verifies that zero, negative, NaN, and infinite weights
+// still produce a valid Treap containing every key.
+func TestInsertWeightedDegenerateWeights(test *testing.T) {
+	// Arrange.
+	var root *TreapNode = nil
+	var weights []float64 = []float64{0, -5, math.NaN(), math.Inf(1), 1}
+
+	// Act.
+	for key := 0; key < 50; key++ {
+		root = InsertWeighted(root, key, weights[key%len(weights)])
+	}
+
+	// Assert.
+	if err := Validate(root); err != nil {
+		test.Fatalf("Validate after degenerate weights: %v.", err)
+	}
+
+	for index, key := range ToSlice(root) {
+		if key != index {
+			test.Fatalf("ToSlice = %v; want 0..49.", ToSlice(root))
+		}
+	}
+
+	if subtreeSize(root) != 50 {
+		test.Errorf("Size = %d after degenerate weights; want 50.", subtreeSize(root))
+	}
+}
+
+// ===================
+// Persistence Testing
+// ===================
+
+// TestPersistentInsertKeepsOldVersions inserts into a version v1 to get v2 and asserts that v1
+// keeps its keys and shape, that v2 holds the new key, and that the versions share subtrees.
+func TestPersistentInsertKeepsOldVersions(test *testing.T) {
+	// Arrange.
+	var version1 *TreapNode = nil
+
+	for key := 0; key < 100; key += 2 {
+		version1 = PersistentInsert(version1, key)
+	}
+
+	var snapshot *TreapNode = Clone(version1)
+	var expected []int = ToSlice(version1)
+
+	// Act.
+	var version2 *TreapNode = PersistentInsert(version1, 51)
+
+	// Assert.
+	if !sameShape(version1, snapshot) {
+		test.Fatal("Expected v1 to keep its keys, priorities, and shape after inserting into it.")
+	}
+
+	if actual := ToSlice(version1); !equalKeys(actual, expected) {
+		test.Errorf("ToSlice(v1) = %v; want %v.", actual, expected)
+	}
+
+	if Search(version2, 51) == nil || Search(version1, 51) != nil {
+		test.Error("Expected key 51 in v2 only.")
+	}
+
+	if err := Validate(version2); err != nil {
+		test.Errorf("Expected a valid v2, got %v.", err)
+	}
+
+	if subtreeSize(version2) != subtreeSize(version1)+1 {
+		test.Errorf("Size of v2 = %d; want %d.", subtreeSize(version2), subtreeSize(version1)+1)
+	}
+
+	if !sharesNode(version1, version2) {
+		test.Error("Expected v2 to share its untouched subtrees with v1.")
+	}
+
+	if PersistentInsert(version2, 51) != version2 {
+		test.Error("Expected inserting an existing key to return the same root.")
+	}
+}
+
+// TestPersistentDeleteKeepsOldVersions records a version after every insert and delete, then
+// asserts that each version still holds exactly the keys it had when it was created.
+func TestPersistentDeleteKeepsOldVersions(test *testing.T) {
+	// Arrange.
+	var random *rand.Rand = rand.New(rand.NewSource(2128))
+	var present map[int]bool = make(map[int]bool)
+	var root *TreapNode = nil
+
+	var versions []*TreapNode
+	var expected [][]int
+
+	// Act.
+	for step := 0; step < 400; step++ {
+		var key int = random.Intn(60)
+
+		if random.Intn(3) == 0 {
+			root = PersistentDelete(root, key)
+			delete(present, key)
+		} else {
+			root = PersistentInsert(root, key)
+			present[key] = true
+		}
+
+		versions = append(versions, root)
+		expected = append(expected, sortedKeys(present, func(int) bool { return true }))
+	}
+
+	// Assert.
+	for index, version := range versions {
+		if err := Validate(version); err != nil {
+			test.Fatalf("Version %d is invalid: %v.", index, err)
+		}
+
+		if actual := ToSlice(version); !equalKeys(actual, expected[index]) {
+			test.Fatalf("ToSlice(version %d) = %v; want %v.", index, actual, expected[index])
+		}
+
+		if subtreeSize(version) != len(expected[index]) {
+			test.Fatalf("Size of version %d = %d; want %d.", index, subtreeSize(version), len(expected[index]))
+		}
+	}
+
+	if PersistentDelete(root, 1000) != root {
+		test.Error("Expected deleting a missing key to return the same root.")
+	}
+}
+
+// ===================
+// Concurrency Testing
+// ===================
+
+// TestSyncTreapConcurrentAccess runs writers inserting disjoint key ranges and deleting their
+// odd keys while readers call Contains and Range, then checks the final set is exactly the
+// even keys. Run with -race to detect unguarded access.
+func TestSyncTreapConcurrentAccess(test *testing.T) {
+	const WRITERS int = 8
+	const READERS int = 8
+	const KEYS_PER_WRITER int = 200
+
+	// Arrange.
+	var set *SyncTreap = NewSyncTreap(2117)
+	var waitGroup sync.WaitGroup
+
+	// Act.
+	for writer := 0; writer < WRITERS; writer++ {
+		waitGroup.Add(1)
+
+		go func(writer int) {
+			defer waitGroup.Done()
+
+			var first int = writer * KEYS_PER_WRITER
+
+			for key := first; key < first+KEYS_PER_WRITER; key++ {
+				set.Insert(key)
+			}
+
+			for key := first + 1; key < first+KEYS_PER_WRITER; key += 2 {
+				set.Delete(key)
+			}
+		}(writer)
+	}
+
+	for reader := 0; reader < READERS; reader++ {
+		waitGroup.Add(1)
+
+		go func(reader int) {
+			defer waitGroup.Done()
+
+			for iteration := 0; iteration < KEYS_PER_WRITER; iteration++ {
+				set.Contains(reader*KEYS_PER_WRITER + iteration)
+
+				var keys []int = set.Range(iteration, iteration+50)
+
+				if !sort.IntsAreSorted(keys) {
+					test.Errorf("Range returned unsorted keys %v.", keys)
+				}
+			}
+		}(reader)
+	}
+
+	waitGroup.Wait()
+
+	// Assert.
+	var expected []int = []int{}
+
+	for key := 0; key < WRITERS*KEYS_PER_WRITER; key += 2 {
+		expected = append(expected, key)
+	}
+
+	if result := set.Range(math.MinInt, math.MaxInt); !equalKeys(result, expected) {
+		test.Errorf("Expected the %d even keys, got %d keys.", len(expected), len(result))
+	}
+
+	if !set.Contains(0) || set.Contains(1) || set.Contains(WRITERS*KEYS_PER_WRITER) {
+		test.Error("Contains disagrees with the final set.")
+	}
+}